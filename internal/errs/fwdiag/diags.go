@@ -19,6 +19,12 @@ func DiagnosticsError(diags diag.Diagnostics) error {
 	var errs []error
 
 	for _, d := range diags.Errors() {
+		// Preserve the wrapped error so that callers can inspect it.
+		if v, ok := d.(interface{ Unwrap() error }); ok {
+			errs = append(errs, wrappedError{msg: DiagnosticString(d), err: v.Unwrap()})
+			continue
+		}
+
 		errs = append(errs, errors.New(DiagnosticString(d)))
 	}
 
@@ -65,14 +71,54 @@ func NewResourceNotFoundWarningDiagnostic(err error) diag.Diagnostic {
 func NewListResultErrorDiagnostic(err error) list.ListResult {
 	return list.ListResult{
 		Diagnostics: diag.Diagnostics{
-			diag.NewErrorDiagnostic(
+			newWrappedErrorDiagnostic(
 				"Error Listing Remote Resources",
-				err.Error(),
+				err,
 			),
 		},
 	}
 }
 
+// wrappedErrorDiagnostic is an error Diagnostic that retains the error it was created from.
+// DiagnosticsError returns an error that wraps the original error.
+type wrappedErrorDiagnostic struct {
+	diag.ErrorDiagnostic
+	err error
+}
+
+func newWrappedErrorDiagnostic(summary string, err error) wrappedErrorDiagnostic {
+	return wrappedErrorDiagnostic{
+		ErrorDiagnostic: diag.NewErrorDiagnostic(summary, err.Error()),
+		err:             err,
+	}
+}
+
+func (d wrappedErrorDiagnostic) Equal(other diag.Diagnostic) bool {
+	o, ok := other.(wrappedErrorDiagnostic)
+	if !ok {
+		return false
+	}
+
+	return d.ErrorDiagnostic.Equal(o.ErrorDiagnostic)
+}
+
+func (d wrappedErrorDiagnostic) Unwrap() error {
+	return d.err
+}
+
+type wrappedError struct {
+	msg string
+	err error
+}
+
+func (e wrappedError) Error() string {
+	return e.msg
+}
+
+func (e wrappedError) Unwrap() error {
+	return e.err
+}
+
 func NewListResultSDKDiagnostics(diags sdkdiag.Diagnostics) list.ListResult {
 	return list.ListResult{
 		Diagnostics: FromSDKDiagnostics(diags),
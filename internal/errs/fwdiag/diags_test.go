@@ -4,6 +4,7 @@
 package fwdiag_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -68,3 +69,24 @@ func TestDiagnosticsError(t *testing.T) {
 		})
 	}
 }
+
+func TestNewListResultErrorDiagnostic(t *testing.T) {
+	t.Parallel()
+
+	errWrapped := errors.New("AccessDeniedException: not authorized")
+
+	result := fwdiag.NewListResultErrorDiagnostic(errWrapped)
+
+	if got, want := result.Diagnostics.ErrorsCount(), 1; got != want {
+		t.Fatalf("ErrorsCount() = %d, want %d", got, want)
+	}
+
+	err := fwdiag.DiagnosticsError(result.Diagnostics)
+
+	if got, want := err.Error(), "Error Listing Remote Resources\n\n"+errWrapped.Error(); got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+	if !errors.Is(err, errWrapped) {
+		t.Errorf("errors.Is() = false, want true")
+	}
+}
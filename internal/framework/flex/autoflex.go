@@ -166,6 +166,15 @@ func autoflexTags(field reflect.StructField) (string, tagOptions) {
 	return parseTag(field.Tag.Get("autoflex"))
 }
 
+// attributeName returns the Terraform attribute name of the specified model field.
+func attributeName(field reflect.StructField) string {
+	if name, _ := parseTag(field.Tag.Get("tfsdk")); name != "" {
+		return name
+	}
+
+	return field.Name
+}
+
 type fieldOpts struct {
 	legacy          bool
 	omitempty       bool
@@ -0,0 +1,21 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package flex_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/autoflex/codegen"
+)
+
+func TestAutoFlexCodeGen(t *testing.T) {
+	t.Parallel()
+
+	g := codegen.NewCodeGenerator(reflect.TypeFor[flex.AutoFlexOptions]().PkgPath(), "flex", codegen.Options{})
+	flex.CodegenTestFuncs(g.Expander, g.Flattener)
+
+	codegen.Generate(t, "autoflex_codegen_gen_test.go", g)
+}
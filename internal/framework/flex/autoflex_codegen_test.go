@@ -0,0 +1,204 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+// This file lists the functions generated by internal/generate/autoflex for AutoFlex tests
+// and compares their results with those of Expand and Flatten.
+//
+// The functions generated into autoflex_codegen_gen_test.go are run by the golden
+// snapshot tests (see autoflex_golden_test.go).
+// TestAutoFlexCodeGen (see autoflex_codegen_ext_test.go) generates the functions.
+//
+// To regenerate autoflex_codegen_gen_test.go:
+//   go generate
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// codegenTestFunc is a function generated into autoflex_codegen_gen_test.go.
+type codegenTestFunc struct {
+	name     string
+	expand   bool
+	from, to any
+}
+
+// codegenTestFuncs are the functions generated into autoflex_codegen_gen_test.go.
+var codegenTestFuncs = []codegenTestFunc{
+	{"expandAllThePrimitiveFields", true, tfAllThePrimitiveFields{}, awsAllThePrimitiveFields{}},
+	{"expandSingleStringFieldToValue", true, tfSingleStringField{}, awsSingleStringValue{}},
+	{"expandSingleStringFieldToPointer", true, tfSingleStringField{}, awsSingleStringPointer{}},
+	{"expandSingleStringFieldToByteSlice", true, tfSingleStringField{}, awsSingleByteSliceValue{}},
+	{"expandSingleStringFieldToInt64", true, tfSingleStringField{}, awsSingleInt64Value{}},
+	{"expandSingleStringFieldToEmpty", true, tfSingleStringField{}, emptyStruct{}},
+	{"expandComplexValue", true, tfComplexValue{}, awsComplexValue{}},
+	{"expandCollectionsOfPrimitiveElements", true, tfCollectionsOfPrimitiveElements{}, awsCollectionsOfPrimitiveElements{}},
+	{"expandMapOfString", true, tfMapOfString{}, awsMapOfString{}},
+	{"expandMapBlockList", true, tfMapBlockList{}, awsMapBlockValues{}},
+	{"expandFlexer", true, tfFlexer{}, awsExpander{}},
+	{"expandExpanderListNestedObjectToSingleStruct", true, tfExpanderListNestedObject{}, awsExpanderSingleStruct{}},
+	{"expandExpanderListNestedObjectToSinglePtr", true, tfExpanderListNestedObject{}, awsExpanderSinglePtr{}},
+	{"expandExpanderListNestedObjectToStructSlice", true, tfExpanderListNestedObject{}, awsExpanderStructSlice{}},
+	{"expandExpanderListNestedObjectToPtrSlice", true, tfExpanderListNestedObject{}, awsExpanderPtrSlice{}},
+	{"expandExpanderObjectValue", true, tfExpanderObjectValue{}, awsExpanderSingleStruct{}},
+	{"expandListNestedObjectToInterface", true, tfListNestedObject[tfSingleStringField]{}, awsInterfaceSingle{}},
+	{"expandDistributionConfig", true, DistributionConfigTF{}, DistributionConfigAWS{}},
+	{"expandDistributionConfigNoXMLWrapper", true, DistributionConfigTFNoXMLWrapper{}, DistributionConfigAWS{}},
+	{"expandRFC3339TimeToValue", true, tfRFC3339Time{}, awsRFC3339TimeValue{}},
+	{"expandRFC3339TimeToPointer", true, tfRFC3339Time{}, awsRFC3339TimePointer{}},
+
+	{"flattenAllThePrimitiveFields", false, awsAllThePrimitiveFields{}, tfAllThePrimitiveFields{}},
+	{"flattenSingleStringValue", false, awsSingleStringValue{}, tfSingleStringField{}},
+	{"flattenSingleStringPointer", false, awsSingleStringPointer{}, tfSingleStringField{}},
+	{"flattenSingleByteSliceValue", false, awsSingleByteSliceValue{}, tfSingleStringField{}},
+	{"flattenSingleStringValueToInt64", false, awsSingleStringValue{}, tfSingleInt64Field{}},
+	{"flattenComplexValue", false, awsComplexValue{}, tfComplexValue{}},
+	{"flattenCollectionsOfPrimitiveElements", false, awsCollectionsOfPrimitiveElements{}, tfCollectionsOfPrimitiveElements{}},
+	{"flattenMapOfString", false, awsMapOfString{}, tfMapOfString{}},
+	{"flattenMapBlockValues", false, awsMapBlockValues{}, tfMapBlockList{}},
+	{"flattenExpanderSinglePtr", false, awsExpanderSinglePtr{}, tfExpanderListNestedObject{}},
+	{"flattenExpanderStructSlice", false, awsExpanderStructSlice{}, tfExpanderListNestedObject{}},
+	{"flattenExpanderSingleStruct", false, awsExpanderSingleStruct{}, tfExpanderObjectValue{}},
+	{"flattenInterfaceSingle", false, awsInterfaceSingle{}, tfListNestedObject[tfSingleStringField]{}},
+	{"flattenDistributionConfigNoXMLWrapper", false, DistributionConfigAWS{}, DistributionConfigTFNoXMLWrapper{}},
+	{"flattenStatusCodes", false, awsStatusCodesForFlatten{}, tfStatusCodesModelForFlatten{}},
+	{"flattenRFC3339TimeValue", false, awsRFC3339TimeValue{}, tfRFC3339Time{}},
+	{"flattenRFC3339TimePointer", false, awsRFC3339TimePointer{}, tfRFC3339Time{}},
+}
+
+// generatedTestFuncKey identifies a generated function by its direction and source and target types.
+type generatedTestFuncKey struct {
+	expand   bool
+	from, to reflect.Type
+}
+
+// generatedTestFunc calls a function in autoflex_codegen_gen_test.go.
+type generatedTestFunc struct {
+	key  generatedTestFuncKey
+	call func(ctx context.Context, from, to any) diag.Diagnostics
+}
+
+func generatedExpander[T, U any](f func(context.Context, T, *U) diag.Diagnostics) generatedTestFunc {
+	return generatedTestFunc{
+		key:  generatedTestFuncKey{expand: true, from: reflect.TypeFor[T](), to: reflect.TypeFor[U]()},
+		call: generatedCall(f),
+	}
+}
+
+func generatedFlattener[T, U any](f func(context.Context, T, *U) diag.Diagnostics) generatedTestFunc {
+	return generatedTestFunc{
+		key:  generatedTestFuncKey{expand: false, from: reflect.TypeFor[T](), to: reflect.TypeFor[U]()},
+		call: generatedCall(f),
+	}
+}
+
+func generatedCall[T, U any](f func(context.Context, T, *U) diag.Diagnostics) func(context.Context, any, any) diag.Diagnostics {
+	return func(ctx context.Context, from, to any) diag.Diagnostics {
+		if v, ok := from.(*T); ok {
+			return f(ctx, *v, to.(*U))
+		}
+		return f(ctx, from.(T), to.(*U))
+	}
+}
+
+func generatedTestFuncsByKey(funcs ...generatedTestFunc) map[generatedTestFuncKey]generatedTestFunc {
+	m := make(map[generatedTestFuncKey]generatedTestFunc, len(funcs))
+	for _, f := range funcs {
+		m[f.key] = f
+	}
+	return m
+}
+
+// generatedTestFuncs are the top-level functions in autoflex_codegen_gen_test.go.
+var generatedTestFuncs = generatedTestFuncsByKey(
+	generatedExpander(expandAllThePrimitiveFields),
+	generatedExpander(expandSingleStringFieldToValue),
+	generatedExpander(expandSingleStringFieldToPointer),
+	generatedExpander(expandSingleStringFieldToByteSlice),
+	generatedExpander(expandSingleStringFieldToInt64),
+	generatedExpander(expandSingleStringFieldToEmpty),
+	generatedExpander(expandComplexValue),
+	generatedExpander(expandCollectionsOfPrimitiveElements),
+	generatedExpander(expandMapOfString),
+	generatedExpander(expandMapBlockList),
+	generatedExpander(expandFlexer),
+	generatedExpander(expandExpanderListNestedObjectToSingleStruct),
+	generatedExpander(expandExpanderListNestedObjectToSinglePtr),
+	generatedExpander(expandExpanderListNestedObjectToStructSlice),
+	generatedExpander(expandExpanderListNestedObjectToPtrSlice),
+	generatedExpander(expandExpanderObjectValue),
+	generatedExpander(expandListNestedObjectToInterface),
+	generatedExpander(expandDistributionConfig),
+	generatedExpander(expandDistributionConfigNoXMLWrapper),
+	generatedExpander(expandRFC3339TimeToValue),
+	generatedExpander(expandRFC3339TimeToPointer),
+	generatedFlattener(flattenAllThePrimitiveFields),
+	generatedFlattener(flattenSingleStringValue),
+	generatedFlattener(flattenSingleStringPointer),
+	generatedFlattener(flattenSingleByteSliceValue),
+	generatedFlattener(flattenSingleStringValueToInt64),
+	generatedFlattener(flattenComplexValue),
+	generatedFlattener(flattenCollectionsOfPrimitiveElements),
+	generatedFlattener(flattenMapOfString),
+	generatedFlattener(flattenMapBlockValues),
+	generatedFlattener(flattenExpanderSinglePtr),
+	generatedFlattener(flattenExpanderStructSlice),
+	generatedFlattener(flattenExpanderSingleStruct),
+	generatedFlattener(flattenInterfaceSingle),
+	generatedFlattener(flattenDistributionConfigNoXMLWrapper),
+	generatedFlattener(flattenStatusCodes),
+	generatedFlattener(flattenRFC3339TimeValue),
+	generatedFlattener(flattenRFC3339TimePointer),
+)
+
+// isZeroTarget returns whether `target` is a non-nil pointer to a zero value.
+func isZeroTarget(target any) bool {
+	v := reflect.ValueOf(target)
+	return v.Kind() == reflect.Pointer && !v.IsNil() && v.Elem().IsZero()
+}
+
+// compareWithGenerated runs the generated function, if any, for a test case's conversion
+// and compares its result with that of the reflection-based conversion.
+// Only test cases with no options and a zero target are compared.
+func compareWithGenerated(t *testing.T, expand bool, tc autoFlexTestCase, zeroTarget bool, diags diag.Diagnostics, opts ...cmp.Option) {
+	t.Helper()
+
+	if len(tc.Options) > 0 || !zeroTarget {
+		return
+	}
+
+	typFrom := reflect.TypeOf(tc.Source)
+	if typFrom == nil {
+		return
+	}
+	if typFrom.Kind() == reflect.Pointer {
+		if reflect.ValueOf(tc.Source).IsNil() {
+			return
+		}
+		typFrom = typFrom.Elem()
+	}
+	typTo := reflect.TypeOf(tc.Target).Elem()
+
+	f, ok := generatedTestFuncs[generatedTestFuncKey{expand: expand, from: typFrom, to: typTo}]
+	if !ok {
+		return
+	}
+
+	target := reflect.New(typTo).Interface()
+	generatedDiags := f.call(context.Background(), tc.Source, target)
+
+	if got, want := generatedDiags.HasError(), diags.HasError(); got != want {
+		t.Errorf("generated function error = %t, reflection error = %t: %v", got, want, generatedDiags)
+	}
+	if !diags.HasError() {
+		if diff := cmp.Diff(target, tc.Target, opts...); diff != "" {
+			t.Errorf("generated function result differs from reflection (-generated, +reflection): %s", diff)
+		}
+	}
+}
@@ -59,6 +59,26 @@ func Expand(ctx context.Context, tfObject, apiObject any, optFns ...AutoFlexOpti
 	return diags
 }
 
+// ExpandField "expands" a single field of a resource's "business logic" data
+// structure into the corresponding field of an AWS SDK for Go v2 API data structure.
+// `tfField` and `apiField` are pointers to the fields.
+// ExpandField is called by generated code (see internal/generate/autoflex) for fields that
+// are not expanded statically.
+func ExpandField(ctx context.Context, tfField, apiField any, optFns ...AutoFlexOptionsFunc) diag.Diagnostics {
+	var diags diag.Diagnostics
+	expander := newAutoExpander(optFns)
+
+	ctx, valFrom, valTo, d := autoFlexValues(ctx, tfField, apiField)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(expander.convert(ctx, path.Empty(), valFrom, path.Empty(), valTo, fieldOpts{})...)
+
+	return diags
+}
+
 type autoExpander struct {
 	Options    AutoFlexOptions
 	fieldCache map[reflect.Type]map[string]reflect.StructField
@@ -178,6 +198,12 @@ func (expander autoExpander) convert(ctx context.Context, sourcePath path.Path,
 		return diags
 	}
 
+	if fromUnion, ok := valFrom.Interface().(Union); ok && vTo.Kind() == reflect.Interface {
+		tflog.SubsystemInfo(ctx, subsystemName, "Source implements flex.Union")
+		diags.Append(expandUnion(ctx, sourcePath, valFrom, fromUnion, targetPath, vTo, expander)...)
+		return diags
+	}
+
 	vFrom, ok := valFrom.Interface().(attr.Value)
 	if !ok {
 		tflog.SubsystemError(ctx, subsystemName, "Source does not implement attr.Value")
@@ -1136,6 +1162,7 @@ func (expander autoExpander) nestedObjectCollection(ctx context.Context, sourceP
 
 		sourcePath := sourcePath.AtListIndex(0)
 		ctx = tflog.SubsystemSetField(ctx, subsystemName, logAttrKeySourcePath, sourcePath.String())
+		ctx = withAttributePathAtIndex(ctx, vFrom, 0)
 		diags.Append(expander.nestedObjectToStruct(ctx, sourcePath, vFrom, targetPath, tTo, vTo)...)
 		return diags
 
@@ -1158,6 +1185,7 @@ func (expander autoExpander) nestedObjectCollection(ctx context.Context, sourceP
 			//
 			sourcePath := sourcePath.AtListIndex(0)
 			ctx = tflog.SubsystemSetField(ctx, subsystemName, logAttrKeySourcePath, sourcePath.String())
+			ctx = withAttributePathAtIndex(ctx, vFrom, 0)
 			diags.Append(expander.nestedObjectToStruct(ctx, sourcePath, vFrom, targetPath, tElem, vTo)...)
 			return diags
 		}
@@ -1168,6 +1196,7 @@ func (expander autoExpander) nestedObjectCollection(ctx context.Context, sourceP
 		//
 		sourcePath := sourcePath.AtListIndex(0)
 		ctx = tflog.SubsystemSetField(ctx, subsystemName, logAttrKeySourcePath, sourcePath.String())
+		ctx = withAttributePathAtIndex(ctx, vFrom, 0)
 		diags.Append(expander.nestedObjectToStruct(ctx, sourcePath, vFrom, targetPath, tTo, vTo)...)
 		return diags
 
@@ -1290,6 +1319,7 @@ func (expander autoExpander) nestedObjectCollectionToSlice(ctx context.Context,
 		targetPath := targetPath.AtListIndex(i)
 		ctx := tflog.SubsystemSetField(ctx, subsystemName, logAttrKeySourcePath, sourcePath.String())
 		ctx = tflog.SubsystemSetField(ctx, subsystemName, logAttrKeyTargetPath, targetPath.String())
+		ctx = withAttributePathAtIndex(ctx, vFrom, i)
 		// Create a new target structure and walk its fields.
 		target := reflect.New(tElem)
 		diags.Append(expandStruct(ctx, sourcePath, f.Index(i).Interface(), targetPath, target.Interface(), expander)...)
@@ -1335,6 +1365,7 @@ func (expander autoExpander) nestedKeyObjectToMap(ctx context.Context, sourcePat
 	for i := range f.Len() {
 		sourcePath := sourcePath.AtListIndex(i)
 		ctx := tflog.SubsystemSetField(ctx, subsystemName, logAttrKeySourcePath, sourcePath.String())
+		ctx = withAttributePathAtIndex(ctx, vFrom, i)
 
 		key, d := mapBlockKey(ctx, f.Index(i).Interface())
 		diags.Append(d...)
@@ -1390,6 +1421,12 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 		return diags
 	}
 
+	if fromUnion, ok := valFrom.Interface().(Union); ok && valTo.Kind() == reflect.Interface {
+		tflog.SubsystemInfo(ctx, subsystemName, "Source implements flex.Union")
+		diags.Append(expandUnion(ctx, sourcePath, valFrom, fromUnion, targetPath, valTo, flexer)...)
+		return diags
+	}
+
 	if valTo.Kind() == reflect.Interface {
 		tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]any{
 			"from": valFrom.Type(),
@@ -1466,6 +1503,7 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 			xmlWrapperField: fromFieldOpts.XMLWrapperField(),
 		}
 
+		ctx := withAttributePath(ctx, attributePathFromContext(ctx).AtName(attributeName(fromField)))
 		diags.Append(flexer.convert(ctx, sourcePath.AtName(fromFieldName), valFrom.FieldByIndex(fromField.Index), targetPath.AtName(toFieldName), toFieldVal, opts)...)
 		if diags.HasError() {
 			break
@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"reflect"
+
+	tfreflect "github.com/hashicorp/terraform-provider-aws/internal/reflect"
+)
+
+// The functions in this file describe, without converting any values, how Expand and Flatten convert between struct types.
+// They are used to generate static expand and flatten functions (see internal/generate/autoflex).
+
+// FieldPair is a source struct field and the target struct field that it's converted to.
+type FieldPair struct {
+	From, To reflect.StructField
+}
+
+// ExpandFieldPairs returns, in conversion order, the fields of the struct type `typFrom` that Expand converts
+// and the corresponding fields of the struct type `typTo`.
+// Fields excluded by `autoflex` struct tags or options aren't returned.
+func ExpandFieldPairs(typFrom, typTo reflect.Type, optFns ...AutoFlexOptionsFunc) []FieldPair {
+	ctx := context.Background()
+	expander := newAutoExpander(optFns)
+
+	var pairs []FieldPair
+	for fromField := range expandSourceFields(ctx, typFrom, expander.getOptions()) {
+		if _, fromFieldOpts := autoflexTags(fromField); fromFieldOpts.NoExpand() {
+			continue
+		}
+
+		toField, ok := (&fuzzyFieldFinder{}).findField(ctx, fromField.Name, typFrom, typTo, expander)
+		if !ok || !toField.IsExported() {
+			continue
+		}
+
+		pairs = append(pairs, FieldPair{From: fromField, To: toField})
+	}
+
+	return pairs
+}
+
+// FlattenFieldPairs returns, in conversion order, the fields of the struct type `typFrom` that Flatten converts
+// and the corresponding fields of the struct type `typTo`.
+// Fields excluded by `autoflex` struct tags or options aren't returned.
+func FlattenFieldPairs(typFrom, typTo reflect.Type, optFns ...AutoFlexOptionsFunc) []FieldPair {
+	ctx := context.Background()
+	flattener := newAutoFlattener(optFns)
+
+	var pairs []FieldPair
+	for fromField := range flattenSourceFields(ctx, typFrom, flattener.getOptions()) {
+		toField, ok := (&fuzzyFieldFinder{}).findField(ctx, fromField.Name, typFrom, typTo, flattener)
+		if !ok || !toField.IsExported() {
+			continue
+		}
+		if toNameOverride, toFieldOpts := autoflexTags(toField); toNameOverride == "-" || toFieldOpts.NoFlatten() {
+			continue
+		}
+
+		pairs = append(pairs, FieldPair{From: fromField, To: toField})
+	}
+
+	return pairs
+}
+
+// RequiresReflection returns whether Expand and Flatten convert between the model struct type `typModel`
+// and the API struct type `typAPI` in ways that depend on run-time values, that is whether the model uses
+// the `legacy`, `omitempty` or `xmlwrapper` tag options or the API structure is or contains an XML wrapper.
+func RequiresReflection(typModel, typAPI reflect.Type, optFns ...AutoFlexOptionsFunc) bool {
+	for field := range tfreflect.ExportedStructFields(typModel) {
+		if _, opts := autoflexTags(field); opts.Legacy() || opts.OmitEmpty() || opts.XMLWrapperField() != "" {
+			return true
+		}
+	}
+
+	if potentialXMLWrapperStruct(typAPI) {
+		return true
+	}
+
+	expander, flattener := newAutoExpander(optFns), newAutoFlattener(optFns)
+	for i := range typAPI.NumField() {
+		typ := typAPI.Field(i).Type
+		if typ.Kind() == reflect.Pointer {
+			typ = typ.Elem()
+		}
+		if typ.Kind() != reflect.Struct {
+			continue
+		}
+		if potentialXMLWrapperStruct(typ) || expander.isXMLWrapperCollapseTarget(typ) || flattener.isXMLWrapperSplitSource(typ) {
+			return true
+		}
+	}
+
+	return false
+}
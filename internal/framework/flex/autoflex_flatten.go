@@ -52,6 +52,26 @@ func Flatten(ctx context.Context, apiObject, tfObject any, optFns ...AutoFlexOpt
 	return diags
 }
 
+// FlattenField "flattens" a single field of an AWS SDK for Go v2 API data
+// structure into the corresponding field of a resource's "business logic" data structure.
+// `apiField` and `tfField` are pointers to the fields.
+// FlattenField is called by generated code (see internal/generate/autoflex) for fields that
+// are not flattened statically.
+func FlattenField(ctx context.Context, apiField, tfField any, optFns ...AutoFlexOptionsFunc) diag.Diagnostics {
+	var diags diag.Diagnostics
+	flattener := newAutoFlattener(optFns)
+
+	ctx, valFrom, valTo, d := autoFlexValues(ctx, apiField, tfField)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(flattener.convert(ctx, path.Empty(), valFrom, path.Empty(), valTo, fieldOpts{})...)
+
+	return diags
+}
+
 type autoFlattener struct {
 	Options    AutoFlexOptions
 	fieldCache map[reflect.Type]map[string]reflect.StructField
@@ -220,7 +240,7 @@ func (flattener autoFlattener) convert(ctx context.Context, sourcePath path.Path
 		return diags
 
 	case reflect.Interface:
-		diags.Append(flattener.interface_(ctx, vFrom, tTo, vTo, fieldOpts)...)
+		diags.Append(flattener.interface_(ctx, sourcePath, vFrom, targetPath, tTo, vTo, fieldOpts)...)
 		return diags
 	}
 
@@ -639,7 +659,7 @@ func (flattener autoFlattener) pointer(ctx context.Context, sourcePath path.Path
 	return diags
 }
 
-func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Value, tTo attr.Type, vTo reflect.Value, _ fieldOpts) diag.Diagnostics {
+func (flattener autoFlattener) interface_(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, targetPath path.Path, tTo attr.Type, vTo reflect.Value, _ fieldOpts) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	switch tTo := tTo.(type) {
@@ -680,7 +700,7 @@ func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Val
 		//
 		// interface -> types.List(OfObject) or types.Object.
 		//
-		diags.Append(flattener.interfaceToNestedObject(ctx, vFrom, vFrom.IsNil(), tTo, vTo)...)
+		diags.Append(flattener.interfaceToNestedObject(ctx, sourcePath, vFrom, vFrom.IsNil(), targetPath, tTo, vTo)...)
 		return diags
 	}
 
@@ -1373,7 +1393,7 @@ func (flattener autoFlattener) structToNestedObject(ctx context.Context, sourceP
 }
 
 // interfaceToNestedObject copies an AWS API interface value to a compatible Plugin Framework NestedObjectValue value.
-func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFrom reflect.Value, isNullFrom bool, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
+func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, isNullFrom bool, targetPath path.Path, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	if isNullFrom {
@@ -1393,7 +1413,31 @@ func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFro
 		return diags
 	}
 
+	// Dereference interface
+	vFrom = vFrom.Elem()
+	// If it's a pointer, dereference again to get the underlying type
+	if vFrom.Kind() == reflect.Pointer {
+		vFrom = vFrom.Elem()
+	}
+
 	toFlattener, ok := to.(Flattener)
+	if toUnion, isUnion := to.(Union); !ok && isUnion {
+		tflog.SubsystemInfo(ctx, subsystemName, "Target implements flex.Union")
+
+		diags.Append(flattenUnion(ctx, sourcePath, vFrom, targetPath, toUnion, flattener)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		val, d := tTo.ValueFromObjectPtr(ctx, to)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(val))
+		return diags
+	}
 	if !ok {
 		val, d := tTo.NullValue(ctx)
 		diags.Append(d...)
@@ -1410,13 +1454,6 @@ func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFro
 
 	tflog.SubsystemInfo(ctx, subsystemName, "Target implements flex.Flattener")
 
-	// Dereference interface
-	vFrom = vFrom.Elem()
-	// If it's a pointer, dereference again to get the underlying type
-	if vFrom.Kind() == reflect.Pointer {
-		vFrom = vFrom.Elem()
-	}
-
 	diags.Append(flattenFlattener(ctx, vFrom, toFlattener)...)
 	if diags.HasError() {
 		return diags
@@ -2215,6 +2252,12 @@ func flattenStruct(ctx context.Context, sourcePath path.Path, from any, targetPa
 		return diags
 	}
 
+	if toUnion, ok := to.(Union); ok {
+		tflog.SubsystemInfo(ctx, subsystemName, "Target implements flex.Union")
+		diags.Append(flattenUnion(ctx, sourcePath, valFrom, targetPath, toUnion, flexer)...)
+		return diags
+	}
+
 	typeFrom := valFrom.Type()
 	typeTo := valTo.Type()
 
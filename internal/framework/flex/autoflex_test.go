@@ -98,6 +98,7 @@ func runAutoExpandTestCases(t *testing.T, testCases autoFlexTestCases, checks ru
 			ctx = tflogtest.RootLogger(ctx, &buf)
 			ctx = registerTestingLogger(ctx)
 
+			zeroTarget := isZeroTarget(tc.Target)
 			diags := Expand(ctx, tc.Source, tc.Target, tc.Options...)
 
 			if checks.CompareDiags {
@@ -116,6 +117,8 @@ func runAutoExpandTestCases(t *testing.T, testCases autoFlexTestCases, checks ru
 				goldenFileName := autoGenerateGoldenPath(t, t.Name(), testName)
 				goldenPath := filepath.Join("testdata", goldenFileName)
 				compareWithGolden(t, goldenPath, normalizedLines)
+
+				compareWithGenerated(t, true, tc, zeroTarget, diags)
 			}
 
 			if checks.PrintLogs {
@@ -151,6 +154,7 @@ func runAutoFlattenTestCases(t *testing.T, testCases autoFlexTestCases, checks r
 			ctx = tflogtest.RootLogger(ctx, &buf)
 			ctx = registerTestingLogger(ctx)
 
+			zeroTarget := isZeroTarget(testCase.Target)
 			diags := Flatten(ctx, testCase.Source, testCase.Target, testCase.Options...)
 
 			if checks.CompareDiags {
@@ -169,6 +173,9 @@ func runAutoFlattenTestCases(t *testing.T, testCases autoFlexTestCases, checks r
 				goldenFileName := autoGenerateGoldenPath(t, t.Name(), testName)
 				goldenPath := filepath.Join("testdata", goldenFileName)
 				compareWithGolden(t, goldenPath, normalizedLines)
+
+				less := func(a, b any) bool { return fmt.Sprintf("%+v", a) < fmt.Sprintf("%+v", b) }
+				compareWithGenerated(t, false, testCase, zeroTarget, diags, append(opts, cmpopts.SortSlices(less))...)
 			}
 
 			if checks.PrintLogs {
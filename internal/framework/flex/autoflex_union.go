@@ -0,0 +1,243 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tfreflect "github.com/hashicorp/terraform-provider-aws/internal/reflect"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+// Union is implemented by models of AWS SDK for Go v2 union (interface) types.
+//
+// A union model has one field per union member, named for the member.
+// For example, the members of `types.Document` are `types.DocumentMemberS3` and `types.DocumentMemberText`,
+// so its model has an `S3` field (a nested object, as the member's value is a structure)
+// and a `Text` field (a string, as the member's value is a string).
+//
+// When expanding, exactly one member field must be set.
+// When flattening, the member field corresponding to the union member is set and all other member fields are null.
+type Union interface {
+	// UnionMembers returns a pointer to each of the union's member types, e.g. `(*types.DocumentMemberS3)(nil)`.
+	UnionMembers() []any
+}
+
+const (
+	unionMemberPrefix         = "Member"
+	unionMemberValueFieldName = "Value"
+)
+
+// unionMember maps an AWS API union member type to its field in a union model.
+type unionMember struct {
+	field reflect.StructField
+	typ   reflect.Type
+}
+
+// unionMembers returns the members of the specified union model.
+// A union member type, e.g. `DocumentMemberS3`, is mapped to the model field, e.g. `S3`, with the longest name matching the end of the type's name.
+// Names are compared case-insensitively, so `StorageConfigurationMemberEfs` is mapped to an `EFS` field.
+func unionMembers(typeModel reflect.Type, union Union) ([]unionMember, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var members []unionMember
+	for _, v := range union.UnionMembers() {
+		typ := reflect.TypeOf(v)
+		if typ == nil || typ.Kind() != reflect.Pointer || typ.Elem().Kind() != reflect.Struct {
+			diags.Append(diagInvalidUnionMember(typeModel, typ))
+			return nil, diags
+		}
+		typ = typ.Elem()
+		if _, ok := typ.FieldByName(unionMemberValueFieldName); !ok {
+			diags.Append(diagInvalidUnionMember(typeModel, typ))
+			return nil, diags
+		}
+
+		var member *unionMember
+		for field := range tfreflect.ExportedStructFields(typeModel) {
+			if name, _ := autoflexTags(field); name == "-" {
+				continue
+			}
+			if !strings.HasSuffix(strings.ToLower(typ.Name()), strings.ToLower(unionMemberPrefix+field.Name)) {
+				continue
+			}
+			if member == nil || len(field.Name) > len(member.field.Name) {
+				member = &unionMember{
+					field: field,
+					typ:   typ,
+				}
+			}
+		}
+
+		if member == nil {
+			diags.Append(diagInvalidUnionMember(typeModel, typ))
+			return nil, diags
+		}
+
+		members = append(members, *member)
+	}
+
+	return members, diags
+}
+
+// isUnionMemberSet returns whether the specified union model member field value is set.
+func isUnionMemberSet(v attr.Value) bool {
+	if v.IsNull() || v.IsUnknown() {
+		return false
+	}
+
+	if v, ok := v.(valueWithElementsAs); ok {
+		return len(v.Elements()) > 0
+	}
+
+	return true
+}
+
+// expandUnion copies the set member field of a Plugin Framework union model to the corresponding AWS API union member.
+func expandUnion(ctx context.Context, sourcePath path.Path, valFrom reflect.Value, fromUnion Union, targetPath path.Path, valTo reflect.Value, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	typeFrom := valFrom.Type()
+	members, d := unionMembers(typeFrom, fromUnion)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var set []unionMember
+	for _, member := range members {
+		if v, ok := valFrom.FieldByIndex(member.field.Index).Interface().(attr.Value); ok && isUnionMemberSet(v) {
+			set = append(set, member)
+		}
+	}
+
+	attributePath := attributePathFromContext(ctx)
+
+	if len(set) == 0 {
+		tflog.SubsystemError(ctx, subsystemName, "No union member is set")
+		attributeNames := tfslices.ApplyToAll(members, func(v unionMember) string {
+			return attributeName(v.field)
+		})
+		diags.Append(diagExpandingUnionNoMemberSet(attributePath, attributeNames))
+		return diags
+	}
+
+	if len(set) > 1 {
+		fieldNames := tfslices.ApplyToAll(set, func(v unionMember) string {
+			return v.field.Name
+		})
+		tflog.SubsystemError(ctx, subsystemName, "Multiple union members are set", map[string]any{
+			logAttrKeySourceFieldname: fieldNames,
+		})
+		attributeNames := tfslices.ApplyToAll(set, func(v unionMember) string {
+			return attributeName(v.field)
+		})
+		diags.Append(diagExpandingUnionMultipleMembersSet(attributePath, attributeNames))
+		return diags
+	}
+
+	member := set[0]
+	to := reflect.New(member.typ)
+	if !to.Type().Implements(valTo.Type()) {
+		diags.Append(diagExpandedTypeDoesNotImplement(to.Type(), valTo.Type()))
+		return diags
+	}
+
+	tflog.SubsystemInfo(ctx, subsystemName, "Expanding union member", map[string]any{
+		logAttrKeySourceFieldname: member.field.Name,
+		logAttrKeyTargetType:      fullTypeName(to.Type()),
+	})
+
+	diags.Append(flexer.convert(ctx, sourcePath.AtName(member.field.Name), valFrom.FieldByIndex(member.field.Index), targetPath.AtName(unionMemberValueFieldName), to.Elem().FieldByName(unionMemberValueFieldName), fieldOpts{})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	valTo.Set(to)
+
+	return diags
+}
+
+// flattenUnion copies an AWS API union member to the corresponding member field of a Plugin Framework union model.
+// All other member fields are set to null.
+func flattenUnion(ctx context.Context, sourcePath path.Path, valFrom reflect.Value, targetPath path.Path, toUnion Union, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	valTo := reflect.Indirect(reflect.ValueOf(toUnion))
+	typeTo := valTo.Type()
+	members, d := unionMembers(typeTo, toUnion)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(flattenPrePopulate(ctx, valTo)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	typeFrom := valFrom.Type()
+	i := slices.IndexFunc(members, func(v unionMember) bool {
+		return v.typ == typeFrom
+	})
+	if i == -1 {
+		tflog.SubsystemError(ctx, subsystemName, "Source is not a union member")
+		diags.Append(diagFlatteningUnknownUnionMember(typeFrom, typeTo))
+		return diags
+	}
+
+	member := members[i]
+
+	tflog.SubsystemInfo(ctx, subsystemName, "Flattening union member", map[string]any{
+		logAttrKeyTargetFieldname: member.field.Name,
+	})
+
+	diags.Append(flexer.convert(ctx, sourcePath.AtName(unionMemberValueFieldName), valFrom.FieldByName(unionMemberValueFieldName), targetPath.AtName(member.field.Name), valTo.FieldByIndex(member.field.Index), fieldOpts{})...)
+
+	return diags
+}
+
+func diagInvalidUnionMember(modelType, memberType reflect.Type) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while converting a union. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Type %q is not a union member of %q.", fullTypeName(memberType), fullTypeName(modelType)),
+	)
+}
+
+func diagExpandingUnionNoMemberSet(attributePath path.Path, attributeNames []string) diag.DiagnosticWithPath {
+	return diag.NewAttributeErrorDiagnostic(
+		attributePath,
+		"Missing Attribute Configuration",
+		fmt.Sprintf("Exactly one of these attributes must be configured: [%s].", strings.Join(attributeNames, ", ")),
+	)
+}
+
+func diagExpandingUnionMultipleMembersSet(attributePath path.Path, attributeNames []string) diag.DiagnosticWithPath {
+	return diag.NewAttributeErrorDiagnostic(
+		attributePath,
+		"Invalid Attribute Combination",
+		fmt.Sprintf("Exactly one of these attributes must be configured, but %d were: [%s].", len(attributeNames), strings.Join(attributeNames, ", ")),
+	)
+}
+
+func diagFlatteningUnknownUnionMember(sourceType, targetType reflect.Type) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while flattening configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Type %q is not a union member of %q.", fullTypeName(sourceType), fullTypeName(targetType)),
+	)
+}
@@ -0,0 +1,362 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+// Tests expansion to and flattening from AWS SDK for Go v2 union (interface) types
+// using union models (flex.Union) with one field per union member.
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+type awsUnion interface {
+	isAWSUnion()
+}
+
+type awsUnionMemberS3 struct {
+	Value awsUnionS3Location
+}
+
+func (*awsUnionMemberS3) isAWSUnion() {} // nosemgrep:ci.aws-in-func-name
+
+type awsUnionMemberText struct {
+	Value string
+}
+
+func (*awsUnionMemberText) isAWSUnion() {} // nosemgrep:ci.aws-in-func-name
+
+type awsUnionUnknownMember struct {
+	Tag   string
+	Value []byte
+}
+
+func (*awsUnionUnknownMember) isAWSUnion() {} // nosemgrep:ci.aws-in-func-name
+
+type awsUnionS3Location struct {
+	Bucket *string
+	Key    *string
+}
+
+type awsUnionSingle struct {
+	Field1 awsUnion
+}
+
+type awsUnionSlice struct {
+	Field1 []awsUnion
+}
+
+type tfUnion struct {
+	S3   fwtypes.ListNestedObjectValueOf[tfUnionS3Location] `tfsdk:"s3"`
+	Text types.String                                       `tfsdk:"text"`
+}
+
+var _ Union = tfUnion{}
+
+func (tfUnion) UnionMembers() []any {
+	return []any{
+		(*awsUnionMemberS3)(nil),
+		(*awsUnionMemberText)(nil),
+	}
+}
+
+type tfUnionS3Location struct {
+	Bucket types.String `tfsdk:"bucket"`
+	Key    types.String `tfsdk:"key"`
+}
+
+type tfUnionMissingMember struct {
+	S3 fwtypes.ListNestedObjectValueOf[tfUnionS3Location] `tfsdk:"s3"`
+}
+
+var _ Union = tfUnionMissingMember{}
+
+func (tfUnionMissingMember) UnionMembers() []any {
+	return []any{
+		(*awsUnionMemberS3)(nil),
+		(*awsUnionMemberText)(nil),
+	}
+}
+
+func testFlexAWSUnionPtr(v awsUnion) *awsUnion { // nosemgrep:ci.aws-in-func-name
+	return &v
+}
+
+func TestExpandUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var targetInterface awsUnion
+
+	testCases := autoFlexTestCases{
+		"top level primitive member": {
+			Source: tfUnion{
+				S3:   fwtypes.NewListNestedObjectValueOfNull[tfUnionS3Location](ctx),
+				Text: types.StringValue("value1"),
+			},
+			Target:     &targetInterface,
+			WantTarget: testFlexAWSUnionPtr(&awsUnionMemberText{Value: "value1"}),
+		},
+		"top level structure member": {
+			Source: tfUnion{
+				S3: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionS3Location{
+					Bucket: types.StringValue("bucket1"),
+					Key:    types.StringValue("key1"),
+				}),
+				Text: types.StringNull(),
+			},
+			Target: &targetInterface,
+			WantTarget: testFlexAWSUnionPtr(&awsUnionMemberS3{
+				Value: awsUnionS3Location{
+					Bucket: aws.String("bucket1"),
+					Key:    aws.String("key1"),
+				},
+			}),
+		},
+		"top level no member set": {
+			Source: tfUnion{
+				S3:   fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnionS3Location{}),
+				Text: types.StringNull(),
+			},
+			Target: &targetInterface,
+			ExpectedDiags: diag.Diagnostics{
+				diagExpandingUnionNoMemberSet(path.Empty(), []string{"s3", "text"}),
+			},
+		},
+		"top level multiple members set": {
+			Source: tfUnion{
+				S3: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionS3Location{
+					Bucket: types.StringValue("bucket1"),
+				}),
+				Text: types.StringValue("value1"),
+			},
+			Target: &targetInterface,
+			ExpectedDiags: diag.Diagnostics{
+				diagExpandingUnionMultipleMembersSet(path.Empty(), []string{"s3", "text"}),
+			},
+		},
+		"top level missing member field": {
+			Source: tfUnionMissingMember{
+				S3: fwtypes.NewListNestedObjectValueOfNull[tfUnionS3Location](ctx),
+			},
+			Target:        &targetInterface,
+			ExpectedDiags: diagAF2[tfUnionMissingMember, awsUnionMemberText](diagInvalidUnionMember),
+		},
+		"single list Source and single interface Target": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						S3:   fwtypes.NewListNestedObjectValueOfNull[tfUnionS3Location](ctx),
+						Text: types.StringValue("value1"),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{
+				Field1: &awsUnionMemberText{Value: "value1"},
+			},
+		},
+		"null list Source and single interface Target": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfNull[tfUnion](ctx),
+			},
+			Target:     &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{},
+		},
+		"list Source and interface slice Target": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						S3:   fwtypes.NewListNestedObjectValueOfNull[tfUnionS3Location](ctx),
+						Text: types.StringValue("value1"),
+					},
+					{
+						S3: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionS3Location{
+							Bucket: types.StringValue("bucket1"),
+							Key:    types.StringNull(),
+						}),
+						Text: types.StringNull(),
+					},
+				}),
+			},
+			Target: &awsUnionSlice{},
+			WantTarget: &awsUnionSlice{
+				Field1: []awsUnion{
+					&awsUnionMemberText{Value: "value1"},
+					&awsUnionMemberS3{
+						Value: awsUnionS3Location{
+							Bucket: aws.String("bucket1"),
+						},
+					},
+				},
+			},
+		},
+		"list Source with no member set and single interface Target": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						S3:   fwtypes.NewListNestedObjectValueOfNull[tfUnionS3Location](ctx),
+						Text: types.StringNull(),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			ExpectedDiags: diag.Diagnostics{
+				diagExpandingUnionNoMemberSet(path.Root("field1").AtListIndex(0), []string{"s3", "text"}),
+			},
+		},
+	}
+
+	runAutoExpandTestCases(t, testCases, runChecks{CompareDiags: true, CompareTarget: true})
+}
+
+func TestFlattenUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		"top level primitive member": {
+			Source: &awsUnionMemberText{Value: "value1"},
+			Target: &tfUnion{},
+			WantTarget: &tfUnion{
+				S3:   fwtypes.NewListNestedObjectValueOfNull[tfUnionS3Location](ctx),
+				Text: types.StringValue("value1"),
+			},
+		},
+		"top level structure member": {
+			Source: &awsUnionMemberS3{
+				Value: awsUnionS3Location{
+					Bucket: aws.String("bucket1"),
+					Key:    aws.String("key1"),
+				},
+			},
+			Target: &tfUnion{},
+			WantTarget: &tfUnion{
+				S3: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionS3Location{
+					Bucket: types.StringValue("bucket1"),
+					Key:    types.StringValue("key1"),
+				}),
+				Text: types.StringNull(),
+			},
+		},
+		"top level unknown member": {
+			Source:        &awsUnionUnknownMember{Tag: "Other"},
+			Target:        &tfUnion{},
+			ExpectedDiags: diagAF2[awsUnionUnknownMember, tfUnion](diagFlatteningUnknownUnionMember),
+		},
+		"nil interface Source and list Target": {
+			Source: awsUnionSingle{},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfNull[tfUnion](ctx),
+			},
+		},
+		"single interface Source and single list Target": {
+			Source: awsUnionSingle{
+				Field1: &awsUnionMemberText{Value: "value1"},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					S3:   fwtypes.NewListNestedObjectValueOfNull[tfUnionS3Location](ctx),
+					Text: types.StringValue("value1"),
+				}),
+			},
+		},
+		"single unknown member interface Source and single list Target": {
+			Source: awsUnionSingle{
+				Field1: &awsUnionUnknownMember{Tag: "Other"},
+			},
+			Target:        &tfListNestedObject[tfUnion]{},
+			ExpectedDiags: diagAF2[awsUnionUnknownMember, tfUnion](diagFlatteningUnknownUnionMember),
+		},
+		"interface slice Source and list Target": {
+			Source: awsUnionSlice{
+				Field1: []awsUnion{
+					&awsUnionMemberS3{
+						Value: awsUnionS3Location{
+							Bucket: aws.String("bucket1"),
+						},
+					},
+					&awsUnionMemberText{Value: "value1"},
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						S3: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionS3Location{
+							Bucket: types.StringValue("bucket1"),
+							Key:    types.StringNull(),
+						}),
+						Text: types.StringNull(),
+					},
+					{
+						S3:   fwtypes.NewListNestedObjectValueOfNull[tfUnionS3Location](ctx),
+						Text: types.StringValue("value1"),
+					},
+				}),
+			},
+		},
+	}
+
+	runAutoFlattenTestCases(t, testCases, runChecks{CompareDiags: true, CompareTarget: true})
+}
+
+type awsUnionMemberEfs struct {
+	Value string
+}
+
+func (*awsUnionMemberEfs) isAWSUnion() {} // nosemgrep:ci.aws-in-func-name
+
+type awsUnionMemberGroupMember struct {
+	Value string
+}
+
+func (*awsUnionMemberGroupMember) isAWSUnion() {} // nosemgrep:ci.aws-in-func-name
+
+type tfUnionFieldNames struct {
+	EFS         types.String `tfsdk:"efs"`
+	GroupMember types.String `tfsdk:"group_member"`
+	Member      types.String `tfsdk:"member"`
+}
+
+func (tfUnionFieldNames) UnionMembers() []any {
+	return []any{
+		(*awsUnionMemberEfs)(nil),
+		(*awsUnionMemberGroupMember)(nil),
+	}
+}
+
+func TestUnionMembers(t *testing.T) {
+	t.Parallel()
+
+	members, diags := unionMembers(reflect.TypeFor[tfUnionFieldNames](), tfUnionFieldNames{})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	got := make(map[string]string)
+	for _, v := range members {
+		got[v.typ.Name()] = v.field.Name
+	}
+	want := map[string]string{
+		"awsUnionMemberEfs":         "EFS",
+		"awsUnionMemberGroupMember": "GroupMember",
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
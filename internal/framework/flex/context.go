@@ -8,6 +8,9 @@ import (
 	"os"
 
 	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -32,3 +35,30 @@ func registerLogger(ctx context.Context, logLevel hclog.Level) context.Context {
 		tflog.WithRootFields(),
 	)
 }
+
+type attributePathKey struct{}
+
+// withAttributePath returns a context carrying the Terraform attribute path, relative to the model being expanded, of the value being expanded.
+// Unlike the source path used for logging, which names model struct fields, the attribute path names attributes and is used in configuration diagnostics.
+func withAttributePath(ctx context.Context, attributePath path.Path) context.Context {
+	return context.WithValue(ctx, attributePathKey{}, attributePath)
+}
+
+// withAttributePathAtIndex returns a context carrying the Terraform attribute path of the element at the specified index of the specified collection.
+// Set elements are identified by value rather than by index, so the path of a set element is the path of the set.
+func withAttributePathAtIndex(ctx context.Context, vFrom attr.Value, i int) context.Context {
+	if _, ok := vFrom.(basetypes.SetValuable); ok {
+		return ctx
+	}
+
+	return withAttributePath(ctx, attributePathFromContext(ctx).AtListIndex(i))
+}
+
+// attributePathFromContext returns the Terraform attribute path carried by the specified context.
+func attributePathFromContext(ctx context.Context) path.Path {
+	if v, ok := ctx.Value(attributePathKey{}).(path.Path); ok {
+		return v
+	}
+
+	return path.Empty()
+}
@@ -0,0 +1,17 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+// Exports for use in tests only.
+
+// CodegenTestFuncs calls `expander` or `flattener` for each function generated into autoflex_codegen_gen_test.go.
+func CodegenTestFuncs(expander, flattener func(name string, from, to any)) {
+	for _, f := range codegenTestFuncs {
+		if f.expand {
+			expander(f.name, f.from, f.to)
+		} else {
+			flattener(f.name, f.from, f.to)
+		}
+	}
+}
@@ -14,7 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
-	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
 )
 
 var (
@@ -127,7 +127,12 @@ func (v IAMPolicy) StringSemanticEquals(_ context.Context, newValuable basetypes
 		return false, diags
 	}
 
-	return verify.PolicyStringsEquivalent(v.ValueString(), newValue.ValueString()), diags
+	equivalent, err := iampolicy.Equivalent(v.ValueString(), newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	return equivalent, diags
 }
 
 func (v IAMPolicy) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
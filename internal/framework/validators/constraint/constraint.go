@@ -0,0 +1,337 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package constraint provides declarative, plan-time validation of AWS constraints that span multiple attributes,
+// e.g. "`provisioned_throughput` is required when `billing_mode` is `PROVISIONED`" or
+// "the KMS key must be in the same Region as the resource".
+//
+// Rules are evaluated against a resource's configuration by a Terraform Plugin Framework config validator
+// (see ResourceConfigValidator) or by a Terraform Plugin SDKv2 CustomizeDiff function (see CustomizeDiff),
+// so the same rules can be used in both plugin stacks.
+//
+// Validation of a rule is delayed while any configuration value that the rule depends on is unknown.
+// Rules only see configuration: null values, including values that the provider or AWS defaults, never match a condition.
+package constraint
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Rule is a constraint between top-level attributes or blocks.
+type Rule interface {
+	// Description describes the rule.
+	Description() string
+
+	validate(context.Context, environment) []problem
+}
+
+// Condition is a predicate on a configuration.
+type Condition interface {
+	// Description describes the condition.
+	Description() string
+
+	// evaluate returns whether the condition holds and whether that is known.
+	evaluate(config) (bool, bool)
+}
+
+// environment is the environment in which rules are evaluated.
+type environment struct {
+	config config
+	// region is the provider's configured Region, if known.
+	region string
+}
+
+// config is a configuration from which top-level attribute and block values can be read.
+type config interface {
+	attribute(name string) value
+}
+
+// value is a configuration value reduced to what rules need.
+// Empty collections are null.
+type value struct {
+	known bool
+	null  bool
+	// primitive is true if the value is a string, number or boolean.
+	primitive bool
+	// strings holds the string representation of a known, non-null primitive value,
+	// or of each known, non-null primitive element of a collection.
+	strings []string
+}
+
+var nullValue = value{known: true, null: true}
+
+// isSet returns whether the value is known and not null.
+func (v value) isSet() bool {
+	return v.known && !v.null
+}
+
+// problem is a rule violation.
+type problem struct {
+	// name is the top-level attribute or block at fault.
+	name   string
+	detail string
+}
+
+func evaluate(ctx context.Context, env environment, rules []Rule) []problem {
+	var problems []problem
+
+	for _, rule := range rules {
+		problems = append(problems, rule.validate(ctx, env)...)
+	}
+
+	return problems
+}
+
+// AttributeValueIn returns a condition that holds when the specified attribute has one of the specified values.
+// Numbers are compared using their shortest decimal representation, e.g. "10" or "0.5", and booleans as "true" or "false".
+func AttributeValueIn(name string, values ...string) Condition {
+	return attributeValueCondition{
+		name:   name,
+		values: values,
+		in:     true,
+	}
+}
+
+// AttributeValueNotIn returns a condition that holds when the specified attribute is configured with a value that isn't one of the specified values.
+func AttributeValueNotIn(name string, values ...string) Condition {
+	return attributeValueCondition{
+		name:   name,
+		values: values,
+	}
+}
+
+type attributeValueCondition struct {
+	name   string
+	values []string
+	in     bool
+}
+
+func (c attributeValueCondition) Description() string {
+	if c.in {
+		return fmt.Sprintf("%q is one of %q", c.name, c.values)
+	}
+
+	return fmt.Sprintf("%q is not one of %q", c.name, c.values)
+}
+
+func (c attributeValueCondition) evaluate(cfg config) (bool, bool) {
+	v := cfg.attribute(c.name)
+
+	if !v.known {
+		return false, false
+	}
+
+	if v.null || !v.primitive {
+		return false, true
+	}
+
+	return slices.Contains(c.values, v.strings[0]) == c.in, true
+}
+
+// AttributeIsSet returns a condition that holds when the specified attribute or block is configured.
+func AttributeIsSet(name string) Condition {
+	return attributeIsSetCondition{
+		name: name,
+	}
+}
+
+type attributeIsSetCondition struct {
+	name string
+}
+
+func (c attributeIsSetCondition) Description() string {
+	return fmt.Sprintf("%q is configured", c.name)
+}
+
+func (c attributeIsSetCondition) evaluate(cfg config) (bool, bool) {
+	v := cfg.attribute(c.name)
+
+	return v.isSet(), v.known
+}
+
+// RequiredWhen returns a rule that the specified attribute or block must be configured when the condition holds.
+func RequiredWhen(name string, condition Condition) Rule {
+	return requiredWhenRule{
+		name:      name,
+		condition: condition,
+	}
+}
+
+type requiredWhenRule struct {
+	name      string
+	condition Condition
+}
+
+func (r requiredWhenRule) Description() string {
+	return fmt.Sprintf("%q must be configured when %s", r.name, r.condition.Description())
+}
+
+func (r requiredWhenRule) validate(_ context.Context, env environment) []problem {
+	if ok, known := r.condition.evaluate(env.config); !ok || !known {
+		return nil
+	}
+
+	// An unknown value may turn out to be null, but that can't be determined until apply.
+	if v := env.config.attribute(r.name); !v.known || !v.null {
+		return nil
+	}
+
+	return []problem{{name: r.name, detail: r.Description()}}
+}
+
+// ConflictsWhen returns a rule that the specified attribute or block cannot be configured when the condition holds.
+func ConflictsWhen(name string, condition Condition) Rule {
+	return conflictsWhenRule{
+		name:      name,
+		condition: condition,
+	}
+}
+
+type conflictsWhenRule struct {
+	name      string
+	condition Condition
+}
+
+func (r conflictsWhenRule) Description() string {
+	return fmt.Sprintf("%q cannot be configured when %s", r.name, r.condition.Description())
+}
+
+func (r conflictsWhenRule) validate(_ context.Context, env environment) []problem {
+	if ok, known := r.condition.evaluate(env.config); !ok || !known {
+		return nil
+	}
+
+	if v := env.config.attribute(r.name); !v.isSet() {
+		return nil
+	}
+
+	return []problem{{name: r.name, detail: r.Description()}}
+}
+
+// ARNsInSameRegion returns a rule that the ARNs configured in the specified attributes must all be in the same Region.
+// Attributes can be strings or collections of strings.
+// Values that aren't ARNs, and ARNs of global resources, e.g. IAM roles, are ignored.
+func ARNsInSameRegion(attributes ...string) Rule {
+	return arnsConsistentRule{
+		names: attributes,
+		noun:  "Region",
+		part:  func(v arn.ARN) string { return v.Region },
+	}
+}
+
+// ARNsInSamePartition returns a rule that the ARNs configured in the specified attributes must all be in the same partition.
+// Attributes can be strings or collections of strings.
+// Values that aren't ARNs are ignored.
+func ARNsInSamePartition(attributes ...string) Rule {
+	return arnsConsistentRule{
+		names: attributes,
+		noun:  "partition",
+		part:  func(v arn.ARN) string { return v.Partition },
+	}
+}
+
+type arnsConsistentRule struct {
+	names []string
+	noun  string
+	part  func(arn.ARN) string
+}
+
+func (r arnsConsistentRule) Description() string {
+	return fmt.Sprintf("ARNs configured in %q must be in the same %s", r.names, r.noun)
+}
+
+func (r arnsConsistentRule) validate(_ context.Context, env environment) []problem {
+	var problems []problem
+	var firstName, first string
+
+	for _, name := range r.names {
+		for _, v := range arns(env.config.attribute(name)) {
+			part := r.part(v)
+			if part == "" {
+				continue
+			}
+
+			if first == "" {
+				firstName, first = name, part
+				continue
+			}
+
+			if part != first {
+				problems = append(problems, problem{
+					name:   name,
+					detail: fmt.Sprintf("%q (%s %s) must be in the same %s as %q (%s %s)", name, r.noun, part, r.noun, firstName, r.noun, first),
+				})
+			}
+		}
+	}
+
+	return problems
+}
+
+// ARNsInConfiguredRegion returns a rule that the ARNs configured in the specified attributes must be in
+// the Region in which the resource is managed, i.e. the value of the `region` argument or, if that isn't configured,
+// the provider's configured Region.
+// Attributes can be strings or collections of strings.
+// Values that aren't ARNs, and ARNs of global resources, e.g. IAM roles, are ignored.
+func ARNsInConfiguredRegion(attributes ...string) Rule {
+	return arnsInConfiguredRegionRule{
+		names: attributes,
+	}
+}
+
+type arnsInConfiguredRegionRule struct {
+	names []string
+}
+
+func (r arnsInConfiguredRegionRule) Description() string {
+	return fmt.Sprintf("ARNs configured in %q must be in the Region in which the resource is managed", r.names)
+}
+
+func (r arnsInConfiguredRegionRule) validate(_ context.Context, env environment) []problem {
+	var region string
+	switch v := env.config.attribute(names.AttrRegion); {
+	case !v.known:
+		return nil
+	case v.isSet() && v.primitive:
+		region = v.strings[0]
+	default:
+		region = env.region
+	}
+
+	if region == "" {
+		return nil
+	}
+
+	var problems []problem
+
+	for _, name := range r.names {
+		for _, v := range arns(env.config.attribute(name)) {
+			if v.Region != "" && v.Region != region {
+				problems = append(problems, problem{
+					name:   name,
+					detail: fmt.Sprintf("%q (Region %s) must be in the Region in which the resource is managed (%s)", name, v.Region, region),
+				})
+			}
+		}
+	}
+
+	return problems
+}
+
+// arns returns the ARNs in a value.
+func arns(v value) []arn.ARN {
+	var result []arn.ARN
+
+	for _, s := range v.strings {
+		if a, err := arn.Parse(s); err == nil {
+			result = append(result, a)
+		}
+	}
+
+	return result
+}
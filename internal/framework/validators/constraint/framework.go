@@ -0,0 +1,220 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package constraint
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ResourceConfigValidator returns a resource configuration validator that evaluates the specified rules.
+func ResourceConfigValidator(rules ...Rule) resource.ConfigValidator {
+	return configValidator{
+		rules: rules,
+	}
+}
+
+// DataSourceConfigValidator returns a data source configuration validator that evaluates the specified rules.
+func DataSourceConfigValidator(rules ...Rule) datasource.ConfigValidator {
+	return configValidator{
+		rules: rules,
+	}
+}
+
+// EphemeralResourceConfigValidator returns an ephemeral resource configuration validator that evaluates the specified rules.
+func EphemeralResourceConfigValidator(rules ...Rule) ephemeral.ConfigValidator {
+	return configValidator{
+		rules: rules,
+	}
+}
+
+// ActionConfigValidator returns an action configuration validator that evaluates the specified rules.
+func ActionConfigValidator(rules ...Rule) action.ConfigValidator {
+	return configValidator{
+		rules: rules,
+	}
+}
+
+var (
+	_ resource.ConfigValidator   = configValidator{}
+	_ datasource.ConfigValidator = configValidator{}
+	_ ephemeral.ConfigValidator  = configValidator{}
+	_ action.ConfigValidator     = configValidator{}
+)
+
+type configValidator struct {
+	rules []Rule
+}
+
+func (v configValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v configValidator) MarkdownDescription(_ context.Context) string {
+	descriptions := make([]string, 0, len(v.rules))
+	for _, rule := range v.rules {
+		descriptions = append(descriptions, rule.Description())
+	}
+
+	return strings.Join(descriptions, "; ")
+}
+
+func (v configValidator) ValidateResource(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	response.Diagnostics.Append(v.validate(ctx, request.Config)...)
+}
+
+func (v configValidator) ValidateDataSource(ctx context.Context, request datasource.ValidateConfigRequest, response *datasource.ValidateConfigResponse) {
+	response.Diagnostics.Append(v.validate(ctx, request.Config)...)
+}
+
+func (v configValidator) ValidateEphemeralResource(ctx context.Context, request ephemeral.ValidateConfigRequest, response *ephemeral.ValidateConfigResponse) {
+	response.Diagnostics.Append(v.validate(ctx, request.Config)...)
+}
+
+func (v configValidator) ValidateAction(ctx context.Context, request action.ValidateConfigRequest, response *action.ValidateConfigResponse) {
+	response.Diagnostics.Append(v.validate(ctx, request.Config)...)
+}
+
+// validate evaluates the rules against a configuration.
+// The provider's configured Region isn't known during configuration validation,
+// so rules that depend on it only apply when the `region` argument is configured.
+func (v configValidator) validate(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	cfg, err := newTFTypesConfig(config.Raw)
+	if err != nil {
+		diags.AddError("Reading configuration", err.Error())
+		return diags
+	}
+
+	if cfg == nil {
+		return diags
+	}
+
+	for _, problem := range evaluate(ctx, environment{config: cfg}, v.rules) {
+		diags.Append(validatordiag.InvalidAttributeCombinationDiagnostic(path.Root(problem.name), problem.detail))
+	}
+
+	return diags
+}
+
+// tftypesConfig is a configuration read from a Terraform Plugin Framework raw configuration value.
+type tftypesConfig map[string]tftypes.Value
+
+// newTFTypesConfig returns a configuration for the specified raw configuration value, or nil if the value is unknown or null.
+func newTFTypesConfig(raw tftypes.Value) (tftypesConfig, error) {
+	if !raw.IsKnown() || raw.IsNull() {
+		return nil, nil
+	}
+
+	var cfg map[string]tftypes.Value
+	if err := raw.As(&cfg); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+func (c tftypesConfig) attribute(name string) value {
+	v, ok := c[name]
+	if !ok {
+		return nullValue
+	}
+
+	return tftypesValue(v)
+}
+
+func tftypesValue(v tftypes.Value) value {
+	if !v.IsKnown() {
+		return value{}
+	}
+
+	if v.IsNull() {
+		return nullValue
+	}
+
+	var elems []tftypes.Value
+	switch v.Type().(type) {
+	case tftypes.List, tftypes.Set, tftypes.Tuple:
+		if err := v.As(&elems); err != nil {
+			return value{}
+		}
+	case tftypes.Map:
+		var m map[string]tftypes.Value
+		if err := v.As(&m); err != nil {
+			return value{}
+		}
+		for _, v := range m {
+			elems = append(elems, v)
+		}
+	case tftypes.Object:
+		return value{known: true}
+	default:
+		s, err := tftypesPrimitive(v)
+		if err != nil {
+			return value{}
+		}
+
+		return value{known: true, primitive: true, strings: []string{s}}
+	}
+
+	if len(elems) == 0 {
+		return nullValue
+	}
+
+	result := value{known: true}
+	for _, v := range elems {
+		if !v.IsKnown() || v.IsNull() {
+			continue
+		}
+
+		if s, err := tftypesPrimitive(v); err == nil {
+			result.strings = append(result.strings, s)
+		}
+	}
+
+	return result
+}
+
+// tftypesPrimitive returns the string representation of a known, non-null primitive value.
+func tftypesPrimitive(v tftypes.Value) (string, error) {
+	switch typ := v.Type(); {
+	case typ.Is(tftypes.String):
+		var s string
+		if err := v.As(&s); err != nil {
+			return "", err
+		}
+
+		return s, nil
+	case typ.Is(tftypes.Number):
+		var f big.Float
+		if err := v.As(&f); err != nil {
+			return "", err
+		}
+
+		return f.Text('f', -1), nil
+	case typ.Is(tftypes.Bool):
+		var b bool
+		if err := v.As(&b); err != nil {
+			return "", err
+		}
+
+		return strconv.FormatBool(b), nil
+	default:
+		return "", fmt.Errorf("unsupported type: %s", typ)
+	}
+}
@@ -0,0 +1,206 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package constraint_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/validators/constraint"
+)
+
+var testSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"billing_mode": schema.StringAttribute{
+			Optional: true,
+		},
+		"kms_key_arn": schema.StringAttribute{
+			Optional: true,
+		},
+		"provisioned_throughput": schema.Int64Attribute{
+			Optional: true,
+		},
+		"region": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+		},
+		"replica_kms_key_arns": schema.SetAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"stream_enabled": schema.BoolAttribute{
+			Optional: true,
+		},
+	},
+}
+
+func testConfig(ctx context.Context, values map[string]tftypes.Value) tfsdk.Config {
+	typ := testSchema.Type().TerraformType(ctx).(tftypes.Object)
+
+	attributes := make(map[string]tftypes.Value)
+	for name, attributeType := range typ.AttributeTypes {
+		if v, ok := values[name]; ok {
+			attributes[name] = v
+		} else {
+			attributes[name] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+
+	return tfsdk.Config{
+		Raw:    tftypes.NewValue(typ, attributes),
+		Schema: testSchema,
+	}
+}
+
+func TestResourceConfigValidator(t *testing.T) {
+	t.Parallel()
+
+	stringSet := tftypes.Set{ElementType: tftypes.String}
+	rules := []constraint.Rule{
+		constraint.RequiredWhen("provisioned_throughput", constraint.AttributeValueIn("billing_mode", "PROVISIONED")),
+		constraint.ConflictsWhen("provisioned_throughput", constraint.AttributeValueNotIn("billing_mode", "PROVISIONED")),
+		constraint.ConflictsWhen("replica_kms_key_arns", constraint.AttributeValueIn("stream_enabled", "false")),
+		constraint.ARNsInSamePartition("kms_key_arn", "replica_kms_key_arns"),
+		constraint.ARNsInConfiguredRegion("kms_key_arn"),
+	}
+
+	testCases := map[string]struct {
+		values          map[string]tftypes.Value
+		expectedDetails []string
+	}{
+		"empty": {},
+		"required when value": {
+			values: map[string]tftypes.Value{
+				"billing_mode": tftypes.NewValue(tftypes.String, "PROVISIONED"),
+			},
+			expectedDetails: []string{
+				`"provisioned_throughput" must be configured when "billing_mode" is one of ["PROVISIONED"]`,
+			},
+		},
+		"required when value satisfied": {
+			values: map[string]tftypes.Value{
+				"billing_mode":           tftypes.NewValue(tftypes.String, "PROVISIONED"),
+				"provisioned_throughput": tftypes.NewValue(tftypes.Number, 10),
+			},
+		},
+		"required when value unknown condition": {
+			values: map[string]tftypes.Value{
+				"billing_mode": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			},
+		},
+		"required when value unknown target": {
+			values: map[string]tftypes.Value{
+				"billing_mode":           tftypes.NewValue(tftypes.String, "PROVISIONED"),
+				"provisioned_throughput": tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			},
+		},
+		"conflicts when value": {
+			values: map[string]tftypes.Value{
+				"billing_mode":           tftypes.NewValue(tftypes.String, "PAY_PER_REQUEST"),
+				"provisioned_throughput": tftypes.NewValue(tftypes.Number, 10),
+			},
+			expectedDetails: []string{
+				`"provisioned_throughput" cannot be configured when "billing_mode" is not one of ["PROVISIONED"]`,
+			},
+		},
+		"conflicts when value null condition": {
+			values: map[string]tftypes.Value{
+				"provisioned_throughput": tftypes.NewValue(tftypes.Number, 10),
+			},
+		},
+		"conflicts when boolean value": {
+			values: map[string]tftypes.Value{
+				"replica_kms_key_arns": tftypes.NewValue(stringSet, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+				}),
+				"stream_enabled": tftypes.NewValue(tftypes.Bool, false),
+			},
+			expectedDetails: []string{
+				`"replica_kms_key_arns" cannot be configured when "stream_enabled" is one of ["false"]`,
+			},
+		},
+		"conflicts when empty collection": {
+			values: map[string]tftypes.Value{
+				"replica_kms_key_arns": tftypes.NewValue(stringSet, []tftypes.Value{}),
+				"stream_enabled":       tftypes.NewValue(tftypes.Bool, false),
+			},
+		},
+		"same partition": {
+			values: map[string]tftypes.Value{
+				"kms_key_arn": tftypes.NewValue(tftypes.String, "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+				"replica_kms_key_arns": tftypes.NewValue(stringSet, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+					tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					tftypes.NewValue(tftypes.String, "alias/example"),
+				}),
+			},
+		},
+		"different partitions": {
+			values: map[string]tftypes.Value{
+				"kms_key_arn": tftypes.NewValue(tftypes.String, "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+				"replica_kms_key_arns": tftypes.NewValue(stringSet, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "arn:aws-us-gov:kms:us-gov-west-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+				}),
+			},
+			expectedDetails: []string{
+				`"replica_kms_key_arns" (partition aws-us-gov) must be in the same partition as "kms_key_arn" (partition aws)`,
+			},
+		},
+		"configured region": {
+			values: map[string]tftypes.Value{
+				"kms_key_arn": tftypes.NewValue(tftypes.String, "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+				"region":      tftypes.NewValue(tftypes.String, "us-west-2"),
+			},
+		},
+		"different region": {
+			values: map[string]tftypes.Value{
+				"kms_key_arn": tftypes.NewValue(tftypes.String, "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+				"region":      tftypes.NewValue(tftypes.String, "eu-west-1"),
+			},
+			expectedDetails: []string{
+				`"kms_key_arn" (Region us-west-2) must be in the Region in which the resource is managed (eu-west-1)`,
+			},
+		},
+		"unknown region": {
+			values: map[string]tftypes.Value{
+				"kms_key_arn": tftypes.NewValue(tftypes.String, "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+				"region":      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			},
+		},
+		// The provider's configured Region isn't known during configuration validation.
+		"default region": {
+			values: map[string]tftypes.Value{
+				"kms_key_arn": tftypes.NewValue(tftypes.String, "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+
+			request := resource.ValidateConfigRequest{
+				Config: testConfig(ctx, testCase.values),
+			}
+			var response resource.ValidateConfigResponse
+			constraint.ResourceConfigValidator(rules...).ValidateResource(ctx, request, &response)
+
+			var details []string
+			for _, v := range response.Diagnostics {
+				details = append(details, v.Detail())
+			}
+
+			if diff := cmp.Diff(details, testCase.expectedDetails); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
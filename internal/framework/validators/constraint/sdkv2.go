@@ -0,0 +1,113 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package constraint
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// CustomizeDiff returns a Terraform Plugin SDKv2 CustomizeDiff function that evaluates the specified rules
+// against the resource's raw configuration.
+// Rules that depend on the provider's configured Region use the Region of the provider's AWS client.
+func CustomizeDiff(rules ...Rule) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		return customizeDiff(ctx, d.GetRawConfig(), meta, rules)
+	}
+}
+
+func customizeDiff(ctx context.Context, raw cty.Value, meta any, rules []Rule) error {
+	cfg := newCtyConfig(raw)
+	if cfg == nil {
+		return nil
+	}
+
+	env := environment{
+		config: cfg,
+	}
+	if v, ok := meta.(interface{ Region(context.Context) string }); ok {
+		env.region = v.Region(ctx)
+	}
+
+	var errs []error
+	for _, problem := range evaluate(ctx, env, rules) {
+		errs = append(errs, errors.New(problem.detail))
+	}
+
+	return errors.Join(errs...)
+}
+
+// ctyConfig is a configuration read from a Terraform Plugin SDKv2 raw configuration value.
+type ctyConfig struct {
+	raw cty.Value
+}
+
+// newCtyConfig returns a configuration for the specified raw configuration value, or nil if the value is unknown, null or not an object.
+func newCtyConfig(raw cty.Value) *ctyConfig {
+	if !raw.Type().IsObjectType() || !raw.IsKnown() || raw.IsNull() {
+		return nil
+	}
+
+	return &ctyConfig{
+		raw: raw,
+	}
+}
+
+func (c *ctyConfig) attribute(name string) value {
+	if !c.raw.Type().HasAttribute(name) {
+		return nullValue
+	}
+
+	return ctyValue(c.raw.GetAttr(name))
+}
+
+func ctyValue(v cty.Value) value {
+	if !v.IsKnown() {
+		return value{}
+	}
+
+	if v.IsNull() {
+		return nullValue
+	}
+
+	typ := v.Type()
+	switch {
+	case typ.IsListType(), typ.IsSetType(), typ.IsMapType(), typ.IsTupleType():
+		if v.LengthInt() == 0 {
+			return nullValue
+		}
+
+		result := value{known: true}
+		for it := v.ElementIterator(); it.Next(); {
+			_, v := it.Element()
+			if !v.IsKnown() || v.IsNull() || !v.Type().IsPrimitiveType() {
+				continue
+			}
+
+			result.strings = append(result.strings, ctyPrimitive(v))
+		}
+
+		return result
+	case typ.IsPrimitiveType():
+		return value{known: true, primitive: true, strings: []string{ctyPrimitive(v)}}
+	default:
+		return value{known: true}
+	}
+}
+
+// ctyPrimitive returns the string representation of a known, non-null primitive value.
+func ctyPrimitive(v cty.Value) string {
+	switch v.Type() {
+	case cty.Number:
+		return v.AsBigFloat().Text('f', -1)
+	case cty.Bool:
+		return strconv.FormatBool(v.True())
+	default:
+		return v.AsString()
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package constraint
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+type testMeta struct {
+	region string
+}
+
+func (m testMeta) Region(context.Context) string {
+	return m.region
+}
+
+// CustomizeDiff's raw configuration is only populated by the gRPC server, so test the underlying function.
+func TestCustomizeDiff(t *testing.T) {
+	t.Parallel()
+
+	rules := []Rule{
+		RequiredWhen("provisioned_throughput", AttributeValueIn("billing_mode", "PROVISIONED")),
+		RequiredWhen("replica", AttributeValueIn("replica_count", "2", "3")),
+		ARNsInSameRegion("kms_key_arn", "replica_kms_key_arns"),
+		ARNsInConfiguredRegion("kms_key_arn"),
+	}
+
+	testConfig := func(values map[string]cty.Value) cty.Value {
+		attributes := map[string]cty.Value{
+			"billing_mode":           cty.NullVal(cty.String),
+			"kms_key_arn":            cty.NullVal(cty.String),
+			"provisioned_throughput": cty.NullVal(cty.Number),
+			"region":                 cty.NullVal(cty.String),
+			"replica":                cty.ListValEmpty(cty.Object(map[string]cty.Type{"region_name": cty.String})),
+			"replica_count":          cty.NullVal(cty.Number),
+			"replica_kms_key_arns":   cty.NullVal(cty.List(cty.String)),
+		}
+		for k, v := range values {
+			attributes[k] = v
+		}
+
+		return cty.ObjectVal(attributes)
+	}
+
+	testCases := map[string]struct {
+		raw           cty.Value
+		region        string
+		expectedError string
+	}{
+		"null": {
+			raw: cty.NilVal,
+		},
+		"unknown": {
+			raw: cty.UnknownVal(cty.Object(map[string]cty.Type{"billing_mode": cty.String})),
+		},
+		"empty": {
+			raw: testConfig(nil),
+		},
+		"required when value": {
+			raw: testConfig(map[string]cty.Value{
+				"billing_mode": cty.StringVal("PROVISIONED"),
+			}),
+			expectedError: `"provisioned_throughput" must be configured when "billing_mode" is one of ["PROVISIONED"]`,
+		},
+		"required when number value": {
+			raw: testConfig(map[string]cty.Value{
+				"replica_count": cty.NumberIntVal(3),
+			}),
+			expectedError: `"replica" must be configured when "replica_count" is one of ["2" "3"]`,
+		},
+		"required when number value satisfied": {
+			raw: testConfig(map[string]cty.Value{
+				"replica": cty.ListVal([]cty.Value{
+					cty.ObjectVal(map[string]cty.Value{"region_name": cty.StringVal("us-east-1")}),
+				}),
+				"replica_count": cty.NumberIntVal(3),
+			}),
+		},
+		"required when value unknown": {
+			raw: testConfig(map[string]cty.Value{
+				"billing_mode": cty.UnknownVal(cty.String),
+			}),
+		},
+		"multiple problems": {
+			raw: testConfig(map[string]cty.Value{
+				"billing_mode": cty.StringVal("PROVISIONED"),
+				"kms_key_arn":  cty.StringVal("arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+				"replica_kms_key_arns": cty.ListVal([]cty.Value{
+					cty.StringVal("arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+				}),
+			}),
+			region: "us-west-2",
+			expectedError: `"provisioned_throughput" must be configured when "billing_mode" is one of ["PROVISIONED"]` + "\n" +
+				`"replica_kms_key_arns" (Region us-east-1) must be in the same Region as "kms_key_arn" (Region us-west-2)`,
+		},
+		"provider region": {
+			raw: testConfig(map[string]cty.Value{
+				"kms_key_arn": cty.StringVal("arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+			}),
+			region:        "us-east-1",
+			expectedError: `"kms_key_arn" (Region us-west-2) must be in the Region in which the resource is managed (us-east-1)`,
+		},
+		"region overrides provider region": {
+			raw: testConfig(map[string]cty.Value{
+				"kms_key_arn": cty.StringVal("arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+				"region":      cty.StringVal("us-west-2"),
+			}),
+			region: "us-east-1",
+		},
+		"global ARN": {
+			raw: testConfig(map[string]cty.Value{
+				"kms_key_arn": cty.StringVal("arn:aws:iam::123456789012:role/example"),
+			}),
+			region: "us-east-1",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := customizeDiff(t.Context(), testCase.raw, testMeta{region: testCase.region}, rules)
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error %q, got no error", testCase.expectedError)
+			}
+
+			if got, want := err.Error(), testCase.expectedError; got != want {
+				t.Errorf("error = %q, want %q", got, want)
+			}
+		})
+	}
+}
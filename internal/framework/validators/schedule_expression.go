@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
+)
+
+// scheduleExpressionValidator validates that a string Attribute's value is a valid schedule expression.
+type scheduleExpressionValidator struct {
+	kinds []schedule.Kind
+}
+
+// Description describes the validation in plain text formatting.
+func (validator scheduleExpressionValidator) Description(_ context.Context) string {
+	if len(validator.kinds) == 0 {
+		return "value must be a valid schedule expression"
+	}
+
+	var kinds []string
+	for _, kind := range validator.kinds {
+		kinds = append(kinds, string(kind)+"(...)")
+	}
+
+	return fmt.Sprintf("value must be a valid %s schedule expression", strings.Join(kinds, " or "))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator scheduleExpressionValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (validator scheduleExpressionValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := schedule.Validate(request.ConfigValue.ValueString(), validator.kinds...); err != nil {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			validator.Description(ctx),
+			err.Error(),
+		))
+		return
+	}
+}
+
+// ScheduleExpression returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a valid at(), cron() or rate() schedule expression.
+//   - Is one of the specified kinds of schedule expression, if any are specified.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func ScheduleExpression(kinds ...schedule.Kind) validator.String {
+	return scheduleExpressionValidator{
+		kinds: kinds,
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+)
+
+const (
+	// arnSectionCount is the number of colon-delimited sections in an ARN.
+	arnSectionCount = 6
+)
+
+// arnImplicitResourceTypes contains the resource types of services whose ARN resource
+// section is only an identifier, for example an SNS topic or SQS queue name.
+var arnImplicitResourceTypes = map[string]string{
+	"sns": "topic",
+	"sqs": "queue",
+}
+
+// parseARN parses an ARN and validates that its partition is a known AWS partition
+// and that its Region, if any, is in that partition.
+func parseARN(s string) (arn.ARN, error) {
+	parts, err := arn.Parse(s)
+	if err != nil {
+		return arn.ARN{}, err
+	}
+
+	var partition endpoints.Partition
+	var ok bool
+	for _, p := range endpoints.DefaultPartitions() {
+		if p.ID() == parts.Partition {
+			partition, ok = p, true
+			break
+		}
+	}
+	if !ok {
+		return arn.ARN{}, fmt.Errorf("unknown partition %q", parts.Partition)
+	}
+
+	if region := parts.Region; region != "" {
+		if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); !ok || p.ID() != partition.ID() {
+			return arn.ARN{}, fmt.Errorf("region %q is not in partition %q", region, partition.ID())
+		}
+	}
+
+	return parts, nil
+}
+
+// arnResourceType splits an ARN's resource section into resource type and resource ID.
+// The resource type is separated from the ID by the first "/" or ":".
+func arnResourceType(parts arn.ARN) (string, string) {
+	resource := parts.Resource
+
+	// Amazon S3 bucket and object ARNs have neither Region nor account ID nor resource type.
+	if parts.Service == "s3" && parts.Region == "" && parts.AccountID == "" {
+		if strings.Contains(resource, "/") {
+			return "object", resource
+		}
+		return "bucket", resource
+	}
+
+	// API Gateway resources are paths, e.g. "/restapis/a123456789".
+	resource = strings.TrimPrefix(resource, "/")
+
+	if i := strings.IndexAny(resource, "/:"); i >= 0 {
+		return resource[:i], resource[i+1:]
+	}
+
+	return arnImplicitResourceTypes[parts.Service], resource
+}
+
+// arnLike returns whether the ARN matches the pattern using the semantics of the IAM ArnLike condition operator.
+// Each of the six colon-delimited sections is matched separately and may contain
+// multi-character ("*") and single-character ("?") wildcards.
+func arnLike(s, pattern string) (bool, error) {
+	sections := strings.SplitN(s, ":", arnSectionCount)
+	patterns := strings.SplitN(pattern, ":", arnSectionCount)
+
+	if len(patterns) != arnSectionCount {
+		return false, fmt.Errorf("pattern %q must have %d colon-delimited sections", pattern, arnSectionCount)
+	}
+
+	for i := range arnSectionCount {
+		if !wildcardMatch(patterns[i], sections[i]) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// wildcardMatch returns whether s matches pattern, in which "*" matches any sequence of characters
+// (including the empty sequence) and "?" matches any single character. Matching is case-sensitive.
+func wildcardMatch(pattern, s string) bool {
+	p, q := []rune(pattern), []rune(s)
+	i, j := 0, 0
+	star, mark := -1, 0
+
+	for j < len(q) {
+		switch {
+		case i < len(p) && (p[i] == '?' || p[i] == q[j]):
+			i++
+			j++
+		case i < len(p) && p[i] == '*':
+			star, mark = i, j
+			i++
+		case star >= 0:
+			i = star + 1
+			mark++
+			j = mark
+		default:
+			return false
+		}
+	}
+
+	for i < len(p) && p[i] == '*' {
+		i++
+	}
+
+	return i == len(p)
+}
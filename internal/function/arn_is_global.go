@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = arnIsGlobalFunction{}
+
+func NewARNIsGlobalFunction() function.Function {
+	return &arnIsGlobalFunction{}
+}
+
+type arnIsGlobalFunction struct{}
+
+func (f arnIsGlobalFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "arn_is_global"
+}
+
+func (f arnIsGlobalFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "arn_is_global Function",
+		MarkdownDescription: "Returns whether an ARN is global, i.e. has no Region. The ARN's partition " +
+			"must be a known AWS partition and its Region, if any, must be in that partition.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "arn",
+				MarkdownDescription: "ARN (Amazon Resource Name)",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f arnIsGlobalFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	parts, err := parseARN(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, parts.Region == ""))
+}
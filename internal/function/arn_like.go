@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = arnLikeFunction{}
+
+func NewARNLikeFunction() function.Function {
+	return &arnLikeFunction{}
+}
+
+type arnLikeFunction struct{}
+
+func (f arnLikeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "arn_like"
+}
+
+func (f arnLikeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "arn_like Function",
+		MarkdownDescription: "Returns whether an ARN matches a pattern, using the semantics of the IAM " +
+			"`ArnLike` condition operator. Each of the six colon-delimited sections of the ARN is matched " +
+			"separately and may contain multi-character (`*`) and single-character (`?`) wildcards.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "arn",
+				MarkdownDescription: "ARN (Amazon Resource Name) to match",
+			},
+			function.StringParameter{
+				Name:                "pattern",
+				MarkdownDescription: "ARN pattern, which may contain wildcards",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f arnLikeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg, pattern string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg, &pattern))
+	if resp.Error != nil {
+		return
+	}
+
+	if _, err := parseARN(arg); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	result, err := arnLike(arg, pattern)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
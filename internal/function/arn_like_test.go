@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+var (
+	expectedErrorInvalidPattern   = regexache.MustCompile(`must[\s\n]*have[\s\n]*6[\s\n]*colon-delimited[\s\n]*sections`)
+	expectedErrorUnknownPartition = regexache.MustCompile(`unknown[\s\n]*partition`)
+)
+
+func TestARNLikeFunction_match(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testARNLikeFunctionConfig("arn:aws:iam::444455556666:role/with/path/example", "arn:aws:iam::44445555666?:role/*"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestARNLikeFunction_noMatch(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testARNLikeFunctionConfig("arn:aws:iam::444455556666:role/example", "arn:aws:iam::*:user/*"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func TestARNLikeFunction_invalidPattern(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testARNLikeFunctionConfig("arn:aws:iam::444455556666:role/example", "arn:aws:*"),
+				ExpectError: expectedErrorInvalidPattern,
+			},
+		},
+	})
+}
+
+func TestARNLikeFunction_unknownPartition(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testARNLikeFunctionConfig("arn:example:iam::444455556666:role/example", "arn:*:iam::*:role/*"),
+				ExpectError: expectedErrorUnknownPartition,
+			},
+		},
+	})
+}
+
+func testARNLikeFunctionConfig(arg, pattern string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::arn_like(%[1]q, %[2]q)
+}`, arg, pattern)
+}
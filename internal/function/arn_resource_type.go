@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var arnResourceTypeResultAttrTypes = map[string]attr.Type{
+	"type": types.StringType,
+	"id":   types.StringType,
+}
+
+var _ function.Function = arnResourceTypeFunction{}
+
+func NewARNResourceTypeFunction() function.Function {
+	return &arnResourceTypeFunction{}
+}
+
+type arnResourceTypeFunction struct{}
+
+func (f arnResourceTypeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "arn_resource_type"
+}
+
+func (f arnResourceTypeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "arn_resource_type Function",
+		MarkdownDescription: "Splits the resource section of an ARN into resource type and resource ID. " +
+			"The resource type is separated from the ID by the first `/` or `:`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "arn",
+				MarkdownDescription: "ARN (Amazon Resource Name)",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: arnResourceTypeResultAttrTypes,
+		},
+	}
+}
+
+func (f arnResourceTypeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	parts, err := parseARN(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resourceType, resourceID := arnResourceType(parts)
+	value := map[string]attr.Value{
+		"type": types.StringValue(resourceType),
+		"id":   types.StringValue(resourceID),
+	}
+
+	result, d := types.ObjectValue(arnResourceTypeResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
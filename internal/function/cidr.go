@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"fmt"
+	"net/netip"
+
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+const (
+	// VPC and subnet netmask limits:
+	// https://docs.aws.amazon.com/vpc/latest/userguide/vpc-cidr-blocks.html
+	// https://docs.aws.amazon.com/vpc/latest/userguide/subnet-sizing.html
+	vpcIPv4MinNetmask    = 16
+	vpcIPv4MaxNetmask    = 28
+	subnetIPv6MinNetmask = 44
+	subnetIPv6MaxNetmask = 64
+
+	// subnetReservedAddressCount is the number of addresses in each subnet that AWS reserves:
+	// the first four addresses and the last address.
+	subnetReservedAddressCount = 5
+)
+
+// parseSubnetCIDRBlock parses and validates a subnet CIDR block.
+func parseSubnetCIDRBlock(cidr string) (netip.Prefix, error) {
+	if err := inttypes.ValidateCIDRBlock(cidr); err != nil {
+		return netip.Prefix{}, err
+	}
+
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+
+	if prefix.Addr().Is4() {
+		if bits := prefix.Bits(); bits < vpcIPv4MinNetmask || bits > vpcIPv4MaxNetmask {
+			return netip.Prefix{}, fmt.Errorf("%q netmask must be between /%d and /%d", cidr, vpcIPv4MinNetmask, vpcIPv4MaxNetmask)
+		}
+	} else {
+		if bits := prefix.Bits(); bits < subnetIPv6MinNetmask || bits > subnetIPv6MaxNetmask {
+			return netip.Prefix{}, fmt.Errorf("%q netmask must be between /%d and /%d", cidr, subnetIPv6MinNetmask, subnetIPv6MaxNetmask)
+		}
+	}
+
+	return prefix, nil
+}
+
+// subnetReservedAddresses returns the addresses that AWS reserves in the specified subnet:
+// the network address, the VPC router, the DNS server, an address reserved for future use
+// and the last address in the subnet.
+func subnetReservedAddresses(prefix netip.Prefix) []netip.Addr {
+	addrs := make([]netip.Addr, 0, subnetReservedAddressCount)
+
+	addr := prefix.Masked().Addr()
+	for range subnetReservedAddressCount - 1 {
+		addrs = append(addrs, addr)
+		addr = addr.Next()
+	}
+
+	return append(addrs, lastAddr(prefix))
+}
+
+// lastAddr returns the last address in the specified network.
+func lastAddr(prefix netip.Prefix) netip.Addr {
+	addr := prefix.Masked().Addr()
+	b := addr.AsSlice()
+
+	for i := range b {
+		if n := prefix.Bits() - i*8; n <= 0 {
+			b[i] = 0xff
+		} else if n < 8 {
+			b[i] |= 0xff >> n
+		}
+	}
+
+	result, _ := netip.AddrFromSlice(b)
+
+	return result
+}
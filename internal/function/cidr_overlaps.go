@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+var _ function.Function = cidrOverlapsFunction{}
+
+func NewCIDROverlapsFunction() function.Function {
+	return &cidrOverlapsFunction{}
+}
+
+type cidrOverlapsFunction struct{}
+
+func (f cidrOverlapsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_overlaps"
+}
+
+func (f cidrOverlapsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_overlaps Function",
+		MarkdownDescription: "Returns whether two CIDR blocks have any addresses in common. An IPv4 CIDR " +
+			"block never overlaps an IPv6 CIDR block.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr_block1",
+				MarkdownDescription: "IPv4 or IPv6 CIDR block",
+			},
+			function.StringParameter{
+				Name:                "cidr_block2",
+				MarkdownDescription: "IPv4 or IPv6 CIDR block",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f cidrOverlapsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrBlock1, cidrBlock2 string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidrBlock1, &cidrBlock2))
+	if resp.Error != nil {
+		return
+	}
+
+	prefixes := make([]netip.Prefix, 2)
+	for i, cidrBlock := range []string{cidrBlock1, cidrBlock2} {
+		if err := inttypes.ValidateCIDRBlock(cidrBlock); err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(int64(i), err.Error()))
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(cidrBlock)
+		if err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(int64(i), err.Error()))
+			continue
+		}
+
+		prefixes[i] = prefix
+	}
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, prefixes[0].Overlaps(prefixes[1])))
+}
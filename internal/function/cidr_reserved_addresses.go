@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = cidrReservedAddressesFunction{}
+
+func NewCIDRReservedAddressesFunction() function.Function {
+	return &cidrReservedAddressesFunction{}
+}
+
+type cidrReservedAddressesFunction struct{}
+
+func (f cidrReservedAddressesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_reserved_addresses"
+}
+
+func (f cidrReservedAddressesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_reserved_addresses Function",
+		MarkdownDescription: "Returns the IP addresses that AWS reserves in a subnet CIDR block: the first " +
+			"four addresses and the last address.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr_block",
+				MarkdownDescription: "Subnet IPv4 or IPv6 CIDR block",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f cidrReservedAddressesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrBlock string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidrBlock))
+	if resp.Error != nil {
+		return
+	}
+
+	prefix, err := parseSubnetCIDRBlock(cidrBlock)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	var result []string
+	for _, addr := range subnetReservedAddresses(prefix) {
+		result = append(result, addr.String())
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+var (
+	expectedErrorInvalidNetmask = regexache.MustCompile(`netmask[\s\n]*must[\s\n]*be[\s\n]*between`)
+)
+
+func TestCIDRReservedAddressesFunction_ipv4(t *testing.T) {
+	t.Parallel()
+	expected := "10.0.1.0,10.0.1.1,10.0.1.2,10.0.1.3,10.0.1.255"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRReservedAddressesFunctionConfig("10.0.1.0/24"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", expected),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRReservedAddressesFunction_ipv6(t *testing.T) {
+	t.Parallel()
+	expected := "2001:db8:1234:1a00::,2001:db8:1234:1a00::1,2001:db8:1234:1a00::2,2001:db8:1234:1a00::3,2001:db8:1234:1a00:ffff:ffff:ffff:ffff"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRReservedAddressesFunctionConfig("2001:db8:1234:1a00::/64"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", expected),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRReservedAddressesFunction_invalidNetmask(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRReservedAddressesFunctionConfig("10.0.0.0/30"),
+				ExpectError: expectedErrorInvalidNetmask,
+			},
+		},
+	})
+}
+
+func testCIDRReservedAddressesFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = join(",", provider::aws::cidr_reserved_addresses(%[1]q))
+}`, arg)
+}
@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+)
+
+// partitionDualStackDNSSuffixes contains the dual-stack (IPv4 and IPv6) DNS suffix of each partition.
+// See https://github.com/aws/aws-sdk-go-v2/blob/main/internal/endpoints/awsrulesfn/partitions.json.
+var partitionDualStackDNSSuffixes = map[string]string{
+	endpoints.AwsPartitionID:      "api.aws",
+	endpoints.AwsCnPartitionID:    "api.amazonwebservices.com.cn",
+	endpoints.AwsEuscPartitionID:  "api.amazonwebservices.eu",
+	endpoints.AwsIsoPartitionID:   "api.aws.ic.gov",
+	endpoints.AwsIsoBPartitionID:  "api.aws.scloud",
+	endpoints.AwsIsoEPartitionID:  "api.cloud-aws.adc-e.uk",
+	endpoints.AwsIsoFPartitionID:  "api.aws.hci.ic.gov",
+	endpoints.AwsUsGovPartitionID: "api.aws",
+}
+
+// partitionForRegion returns the partition that includes the specified Region.
+func partitionForRegion(region string) (endpoints.Partition, error) {
+	if partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok {
+		return partition, nil
+	}
+
+	return endpoints.Partition{}, fmt.Errorf("no partition includes region %q", region)
+}
+
+// globalServiceEndpoint is the endpoint of a global service in a partition.
+type globalServiceEndpoint struct {
+	hostname     string
+	fipsHostname string // Empty if the service has no FIPS endpoint in the partition.
+}
+
+// globalServiceEndpoints contains the endpoints of global services, which have a single endpoint per partition
+// that doesn't follow the standard endpoint naming rules, keyed by service and partition.
+// A global service has no endpoint in a partition that isn't listed.
+// See https://docs.aws.amazon.com/general/latest/gr/rande.html#global-endpoints.
+var globalServiceEndpoints = map[string]map[string]globalServiceEndpoint{
+	"budgets": {
+		endpoints.AwsPartitionID:   {hostname: "budgets.amazonaws.com"},
+		endpoints.AwsCnPartitionID: {hostname: "budgets.amazonaws.com.cn"},
+	},
+	"cloudfront": {
+		endpoints.AwsPartitionID:   {hostname: "cloudfront.amazonaws.com", fipsHostname: "cloudfront-fips.amazonaws.com"},
+		endpoints.AwsCnPartitionID: {hostname: "cloudfront.cn-northwest-1.amazonaws.com.cn"},
+	},
+	"iam": {
+		endpoints.AwsPartitionID:      {hostname: "iam.amazonaws.com", fipsHostname: "iam-fips.amazonaws.com"},
+		endpoints.AwsCnPartitionID:    {hostname: "iam.cn-north-1.amazonaws.com.cn"},
+		endpoints.AwsUsGovPartitionID: {hostname: "iam.us-gov.amazonaws.com", fipsHostname: "iam.us-gov.amazonaws.com"},
+	},
+	"route53": {
+		endpoints.AwsPartitionID:      {hostname: "route53.amazonaws.com", fipsHostname: "route53-fips.amazonaws.com"},
+		endpoints.AwsCnPartitionID:    {hostname: "route53.amazonaws.com.cn"},
+		endpoints.AwsUsGovPartitionID: {hostname: "route53.us-gov.amazonaws.com", fipsHostname: "route53.us-gov.amazonaws.com"},
+	},
+	"waf": {
+		endpoints.AwsPartitionID: {hostname: "waf.amazonaws.com", fipsHostname: "waf-fips.amazonaws.com"},
+	},
+}
+
+// legacyDualStackServices contains the services whose dual-stack endpoints are named
+// `<service>[-fips].dualstack.<region>.<dns-suffix>` instead of using the partition's dual-stack DNS suffix.
+var legacyDualStackServices = map[string]struct{}{
+	"s3":         {},
+	"s3-control": {},
+}
+
+// nonStandardServices contains services whose endpoints follow neither the standard endpoint naming rules
+// nor a single endpoint per partition, e.g. services only available in one Region of a partition.
+var nonStandardServices = map[string]struct{}{
+	"ce":                {},
+	"globalaccelerator": {},
+	"health":            {},
+	"importexport":      {},
+	"networkmanager":    {},
+	"organizations":     {},
+	"shield":            {},
+}
+
+// serviceEndpoint returns the URL of the specified service's endpoint in the given Region.
+// Global services return their partition's endpoint, and an error is returned for services
+// whose endpoints can't be determined without calling AWS.
+func serviceEndpoint(service, region string, fips, dualStack bool) (string, error) {
+	partition, err := partitionForRegion(region)
+	if err != nil {
+		return "", err
+	}
+
+	if _, ok := partition.Services()[service]; !ok {
+		return "", fmt.Errorf("service %q is not available in partition %q", service, partition.ID())
+	}
+
+	if _, ok := nonStandardServices[service]; ok {
+		return "", fmt.Errorf("service %q does not follow the standard endpoint naming rules", service)
+	}
+
+	if v, ok := globalServiceEndpoints[service]; ok {
+		return globalEndpoint(service, partition.ID(), v, fips, dualStack)
+	}
+
+	if _, ok := legacyDualStackServices[service]; ok && dualStack {
+		if fips {
+			service += "-fips"
+		}
+
+		return fmt.Sprintf("https://%s.dualstack.%s.%s", service, region, partition.DNSSuffix()), nil
+	}
+
+	dnsSuffix := partition.DNSSuffix()
+	if dualStack {
+		dnsSuffix = partitionDualStackDNSSuffixes[partition.ID()]
+		if dnsSuffix == "" {
+			return "", fmt.Errorf("partition %q does not support dual-stack endpoints", partition.ID())
+		}
+	}
+
+	if fips {
+		service += "-fips"
+	}
+
+	return fmt.Sprintf("https://%s.%s.%s", service, region, dnsSuffix), nil
+}
+
+func globalEndpoint(service, partitionID string, partitionEndpoints map[string]globalServiceEndpoint, fips, dualStack bool) (string, error) {
+	endpoint, ok := partitionEndpoints[partitionID]
+	if !ok {
+		return "", fmt.Errorf("global service %q has no endpoint in partition %q", service, partitionID)
+	}
+
+	if dualStack {
+		return "", fmt.Errorf("global service %q has no dual-stack endpoint", service)
+	}
+
+	hostname := endpoint.hostname
+	if fips {
+		hostname = endpoint.fipsHostname
+		if hostname == "" {
+			return "", fmt.Errorf("global service %q has no FIPS endpoint in partition %q", service, partitionID)
+		}
+	}
+
+	return "https://" + hostname, nil
+}
@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
+)
+
+var _ function.Function = iamPolicyEqualFunction{}
+
+func NewIAMPolicyEqualFunction() function.Function {
+	return &iamPolicyEqualFunction{}
+}
+
+type iamPolicyEqualFunction struct{}
+
+func (f iamPolicyEqualFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_equal"
+}
+
+func (f iamPolicyEqualFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_equal Function",
+		MarkdownDescription: "Returns whether two IAM policy documents are semantically equivalent, using the " +
+			"same comparison the provider uses to suppress policy differences.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "document1",
+				MarkdownDescription: "IAM policy document in JSON format",
+			},
+			function.StringParameter{
+				Name:                "document2",
+				MarkdownDescription: "IAM policy document in JSON format",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f iamPolicyEqualFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var document1, document2 string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &document1, &document2))
+	if resp.Error != nil {
+		return
+	}
+
+	for i, document := range []string{document1, document2} {
+		if _, err := iampolicy.Canonicalize(document); err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(int64(i), err.Error()))
+		}
+	}
+	if resp.Error != nil {
+		return
+	}
+
+	equivalent, err := iampolicy.Equivalent(document1, document2)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, equivalent))
+}
@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"time"
+	_ "time/tzdata" // Embed the IANA Time Zone database so that time zones can be loaded on all platforms.
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
+)
+
+const (
+	scheduleNextMaxCount = 100
+)
+
+var _ function.Function = scheduleNextFunction{}
+
+func NewScheduleNextFunction() function.Function {
+	return &scheduleNextFunction{}
+}
+
+type scheduleNextFunction struct{}
+
+func (f scheduleNextFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "schedule_next"
+}
+
+func (f scheduleNextFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "schedule_next Function",
+		MarkdownDescription: "Returns the next times at which an `at()`, `cron()` or `rate()` schedule expression " +
+			"fires after a start time, as RFC3339 timestamps in the specified time zone.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "expression",
+				MarkdownDescription: "Schedule expression",
+			},
+			function.Int64Parameter{
+				Name:                "count",
+				MarkdownDescription: "Maximum number of times to return, between 1 and 100",
+			},
+			function.StringParameter{
+				Name:                "timezone",
+				MarkdownDescription: "IANA time zone in which the schedule expression is evaluated, e.g. `UTC` or `America/New_York`",
+			},
+			function.StringParameter{
+				Name:                "start_time",
+				MarkdownDescription: "RFC3339 timestamp after which to return times, e.g. the result of `plantimestamp()`",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f scheduleNextFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var expression, timezone, startTime string
+	var count int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &expression, &count, &timezone, &startTime))
+	if resp.Error != nil {
+		return
+	}
+
+	s, err := schedule.Parse(expression)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+	}
+
+	if count < 1 || count > scheduleNextMaxCount {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, "count must be between 1 and 100"))
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, err.Error()))
+	}
+
+	t, err := time.Parse(time.RFC3339, startTime)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(3, err.Error()))
+	}
+
+	if resp.Error != nil {
+		return
+	}
+
+	result := make([]string, 0, count)
+	for range count {
+		var ok bool
+		if t, ok = s.Next(t, loc); !ok {
+			break
+		}
+
+		result = append(result, t.Format(time.RFC3339))
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+var (
+	expectedErrorInvalidScheduleExpression = regexache.MustCompile(`not[\s\n]*a[\s\n]*valid[\s\n]*schedule[\s\n]*expression`)
+	expectedErrorInvalidCount              = regexache.MustCompile(`count[\s\n]*must[\s\n]*be[\s\n]*between[\s\n]*1[\s\n]*and[\s\n]*100`)
+	expectedErrorUnknownTimeZone           = regexache.MustCompile(`unknown[\s\n]*time[\s\n]*zone`)
+)
+
+func TestScheduleNextFunction_cron(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testScheduleNextFunctionConfig("cron(0 9 ? * MON-FRI *)", 3, "America/New_York", "2026-10-16T12:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2026-10-16T09:00:00-04:00,2026-10-19T09:00:00-04:00,2026-10-20T09:00:00-04:00"),
+				),
+			},
+		},
+	})
+}
+
+func TestScheduleNextFunction_rate(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testScheduleNextFunctionConfig("rate(12 hours)", 2, "UTC", "2026-10-18T14:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2026-10-19T02:00:00Z,2026-10-19T14:00:00Z"),
+				),
+			},
+		},
+	})
+}
+
+func TestScheduleNextFunction_at(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testScheduleNextFunctionConfig("at(2026-11-20T13:00:00)", 5, "Europe/London", "2026-10-18T14:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2026-11-20T13:00:00Z"),
+				),
+			},
+		},
+	})
+}
+
+func TestScheduleNextFunction_invalidExpression(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testScheduleNextFunctionConfig("cron(0 12 * * ?)", 1, "UTC", "2026-10-18T14:00:00Z"),
+				ExpectError: expectedErrorInvalidScheduleExpression,
+			},
+		},
+	})
+}
+
+func TestScheduleNextFunction_invalidCount(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testScheduleNextFunctionConfig("rate(1 day)", 0, "UTC", "2026-10-18T14:00:00Z"),
+				ExpectError: expectedErrorInvalidCount,
+			},
+		},
+	})
+}
+
+func TestScheduleNextFunction_invalidTimezone(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testScheduleNextFunctionConfig("rate(1 day)", 1, "Mars/Olympus_Mons", "2026-10-18T14:00:00Z"),
+				ExpectError: expectedErrorUnknownTimeZone,
+			},
+		},
+	})
+}
+
+func testScheduleNextFunctionConfig(expression string, count int, timezone, startTime string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = join(",", provider::aws::schedule_next(%[1]q, %[2]d, %[3]q, %[4]q))
+}
+`, expression, count, timezone, startTime)
+}
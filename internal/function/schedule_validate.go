@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
+)
+
+var _ function.Function = scheduleValidateFunction{}
+
+func NewScheduleValidateFunction() function.Function {
+	return &scheduleValidateFunction{}
+}
+
+type scheduleValidateFunction struct{}
+
+func (f scheduleValidateFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "schedule_validate"
+}
+
+func (f scheduleValidateFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "schedule_validate Function",
+		MarkdownDescription: "Returns whether a string is a valid `at()`, `cron()` or `rate()` schedule expression, " +
+			"as accepted by Amazon EventBridge, EventBridge Scheduler, AWS Backup and AWS Glue.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "expression",
+				MarkdownDescription: "Schedule expression",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f scheduleValidateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var expression string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &expression))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, schedule.Validate(expression) == nil))
+}
@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = serviceEndpointFunction{}
+
+func NewServiceEndpointFunction() function.Function {
+	return &serviceEndpointFunction{}
+}
+
+type serviceEndpointFunction struct{}
+
+func (f serviceEndpointFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "service_endpoint"
+}
+
+func (f serviceEndpointFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "service_endpoint Function",
+		MarkdownDescription: "Returns the URL of an AWS service endpoint in a Region, using the provider's embedded " +
+			"partition metadata. Global services return their partition's endpoint.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "service",
+				MarkdownDescription: "Service endpoint identifier, e.g. `ec2` or `execute-api`",
+			},
+			function.StringParameter{
+				Name:                "region",
+				MarkdownDescription: "Region code",
+			},
+			function.BoolParameter{
+				Name:                "fips",
+				MarkdownDescription: "Whether to return the FIPS endpoint",
+			},
+			function.BoolParameter{
+				Name:                "dualstack",
+				MarkdownDescription: "Whether to return the dual-stack (IPv4 and IPv6) endpoint",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f serviceEndpointFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var service, region string
+	var fips, dualStack bool
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &service, &region, &fips, &dualStack))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := serviceEndpoint(service, region, fips, dualStack)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,211 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+var (
+	expectedErrorServiceNotAvailable  = regexache.MustCompile(`is[\s\n]*not[\s\n]*available[\s\n]*in[\s\n]*partition`)
+	expectedErrorNoDualStackEndpoint  = regexache.MustCompile(`has[\s\n]*no[\s\n]*dual-stack[\s\n]*endpoint`)
+	expectedErrorNonStandardEndpoints = regexache.MustCompile(`does[\s\n]*not[\s\n]*follow[\s\n]*the[\s\n]*standard[\s\n]*endpoint`)
+)
+
+func TestServiceEndpointFunction_standard(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testServiceEndpointFunctionConfig("ec2", "us-west-2", false, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "https://ec2.us-west-2.amazonaws.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestServiceEndpointFunction_fips(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testServiceEndpointFunctionConfig("ec2", "us-gov-west-1", true, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "https://ec2-fips.us-gov-west-1.amazonaws.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestServiceEndpointFunction_dualStack(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testServiceEndpointFunctionConfig("ec2", "cn-north-1", false, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "https://ec2.cn-north-1.api.amazonwebservices.com.cn"),
+				),
+			},
+		},
+	})
+}
+
+func TestServiceEndpointFunction_unknownService(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testServiceEndpointFunctionConfig("notaservice", "us-west-2", false, false),
+				ExpectError: expectedErrorServiceNotAvailable,
+			},
+		},
+	})
+}
+
+func TestServiceEndpointFunction_global(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testServiceEndpointFunctionConfig("iam", "us-east-1", false, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "https://iam.amazonaws.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestServiceEndpointFunction_globalGovCloudFIPS(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testServiceEndpointFunctionConfig("route53", "us-gov-west-1", true, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "https://route53.us-gov.amazonaws.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestServiceEndpointFunction_globalChina(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testServiceEndpointFunctionConfig("iam", "cn-northwest-1", false, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "https://iam.cn-north-1.amazonaws.com.cn"),
+				),
+			},
+		},
+	})
+}
+
+func TestServiceEndpointFunction_legacyDualStack(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testServiceEndpointFunctionConfig("s3", "us-west-2", true, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "https://s3-fips.dualstack.us-west-2.amazonaws.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestServiceEndpointFunction_globalDualStack(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testServiceEndpointFunctionConfig("cloudfront", "us-east-1", false, true),
+				ExpectError: expectedErrorNoDualStackEndpoint,
+			},
+		},
+	})
+}
+
+func TestServiceEndpointFunction_nonStandard(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testServiceEndpointFunctionConfig("organizations", "us-east-1", false, false),
+				ExpectError: expectedErrorNonStandardEndpoints,
+			},
+		},
+	})
+}
+
+func testServiceEndpointFunctionConfig(service, region string, fips, dualStack bool) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::service_endpoint(%[1]q, %[2]q, %[3]t, %[4]t)
+}`, service, region, fips, dualStack)
+}
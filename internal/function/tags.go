@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/YakDriver/regexache"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	awsTagKeyPrefix   = "aws:" // nosemgrep:ci.aws-in-const-name,ci.aws-in-var-name
+	tagKeyMaxLength   = 128
+	tagValueMaxLength = 256
+	tagsMaxCount      = 50
+)
+
+// See https://docs.aws.amazon.com/tag-editor/latest/userguide/tagging.html#tag-conventions.
+var tagCharactersRegexp = regexache.MustCompile(`^[\p{L}\p{Z}\p{N}_.:/=+\-@]*$`)
+
+// validateTags returns an error describing every way in which the specified tags break
+// the AWS tagging rules, including any restrictions specific to the specified service.
+func validateTags(tags tftags.KeyValueTags, serviceName string) error {
+	var errs []error
+
+	if n := len(tags); n > tagsMaxCount {
+		errs = append(errs, fmt.Errorf("%d tags specified, must be at most %d", n, tagsMaxCount))
+	}
+
+	for _, k := range tags.Keys() {
+		v := tags.KeyTagData(k).ValueString()
+
+		if n := utf8.RuneCountInString(k); n < 1 || n > tagKeyMaxLength {
+			errs = append(errs, fmt.Errorf("tag key %q must be between 1 and %d characters", k, tagKeyMaxLength))
+		}
+		if !tagCharactersRegexp.MatchString(k) {
+			errs = append(errs, fmt.Errorf("tag key %q contains invalid characters", k))
+		}
+		if n := utf8.RuneCountInString(v); n > tagValueMaxLength {
+			errs = append(errs, fmt.Errorf("value of tag %q must be at most %d characters", k, tagValueMaxLength))
+		}
+		if !tagCharactersRegexp.MatchString(v) {
+			errs = append(errs, fmt.Errorf("value of tag %q contains invalid characters", k))
+		}
+
+		if hasTagKeyPrefixFold(k, awsTagKeyPrefix) {
+			errs = append(errs, fmt.Errorf("tag key %q uses the reserved prefix %q", k, awsTagKeyPrefix))
+		}
+
+		switch serviceName {
+		case names.ElasticBeanstalk:
+			if hasTagKeyPrefixFold(k, tftags.ElasticbeanstalkTagKeyPrefix) {
+				errs = append(errs, fmt.Errorf("tag key %q uses the reserved prefix %q", k, tftags.ElasticbeanstalkTagKeyPrefix))
+			}
+			if k == tftags.NameTagKey {
+				errs = append(errs, fmt.Errorf("tag key %q is reserved", k))
+			}
+		case names.ServerlessRepo:
+			if hasTagKeyPrefixFold(k, tftags.ServerlessApplicationRepositoryTagKeyPrefix) {
+				errs = append(errs, fmt.Errorf("tag key %q uses the reserved prefix %q", k, tftags.ServerlessApplicationRepositoryTagKeyPrefix))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func hasTagKeyPrefixFold(k, prefix string) bool {
+	return len(k) >= len(prefix) && strings.EqualFold(k[:len(prefix)], prefix)
+}
@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+var _ function.Function = tagsMergeFunction{}
+
+func NewTagsMergeFunction() function.Function {
+	return &tagsMergeFunction{}
+}
+
+type tagsMergeFunction struct{}
+
+func (f tagsMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tags_merge"
+}
+
+func (f tagsMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "tags_merge Function",
+		MarkdownDescription: "Merges tag maps in the same way as the provider merges `default_tags` with resource `tags`. " +
+			"Tags in later maps override tags with the same key in earlier maps, and a tag with a `null` value removes the tag.",
+		VariadicParameter: function.MapParameter{
+			Name:                "tags",
+			MarkdownDescription: "Tag maps to merge",
+			ElementType:         types.StringType,
+			AllowNullValue:      true,
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f tagsMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var tagMaps []map[string]*string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &tagMaps))
+	if resp.Error != nil {
+		return
+	}
+
+	merged := make(tftags.KeyValueTags)
+	for _, m := range tagMaps {
+		merged = merged.Merge(tftags.New(ctx, m))
+	}
+
+	result := make(map[string]string, len(merged))
+	for k, v := range merged {
+		if v == nil || v.Value == nil {
+			continue
+		}
+
+		result[k] = *v.Value
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestTagsMergeFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testTagsMergeFunctionConfig(`{ Environment = "dev", Owner = "platform" }`, `{ Environment = "prod", Project = "example" }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Environment":"prod","Owner":"platform","Project":"example"}`),
+				),
+			},
+		},
+	})
+}
+
+func TestTagsMergeFunction_nullValue(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testTagsMergeFunctionConfig(`{ Environment = "dev", Owner = "platform" }`, `{ Owner = null }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Environment":"dev"}`),
+				),
+			},
+		},
+	})
+}
+
+func TestTagsMergeFunction_nullMap(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testTagsMergeFunctionConfig(`{ Environment = "dev" }`, `null`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Environment":"dev"}`),
+				),
+			},
+		},
+	})
+}
+
+func testTagsMergeFunctionConfig(tags1, tags2 string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = jsonencode(provider::aws::tags_merge(%[1]s, %[2]s))
+}
+`, tags1, tags2)
+}
@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+var _ function.Function = tagsValidateFunction{}
+
+func NewTagsValidateFunction() function.Function {
+	return &tagsValidateFunction{}
+}
+
+type tagsValidateFunction struct{}
+
+func (f tagsValidateFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tags_validate"
+}
+
+func (f tagsValidateFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "tags_validate Function",
+		MarkdownDescription: "Returns whether tags satisfy the AWS tag key and value length and character rules, " +
+			"and any restrictions specific to a service.",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:                "tags",
+				MarkdownDescription: "Tags",
+				ElementType:         types.StringType,
+			},
+			function.StringParameter{
+				Name:                "service",
+				MarkdownDescription: "Service package name, e.g. `elasticbeanstalk`, or an empty string for no service-specific restrictions",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f tagsValidateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var tags map[string]string
+	var service string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &tags, &service))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, validateTags(tftags.New(ctx, tags), service) == nil))
+}
@@ -0,0 +1,52 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+var _ function.Function = tagsWithoutAWSPrefixFunction{}
+
+func NewTagsWithoutAWSPrefixFunction() function.Function { // nosemgrep:ci.aws-in-func-name
+	return &tagsWithoutAWSPrefixFunction{}
+}
+
+type tagsWithoutAWSPrefixFunction struct{} // nosemgrep:ci.aws-in-type-name
+
+func (f tagsWithoutAWSPrefixFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tags_without_aws_prefix"
+}
+
+func (f tagsWithoutAWSPrefixFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "tags_without_aws_prefix Function",
+		MarkdownDescription: "Removes tags whose keys begin with the reserved `aws:` prefix, as the provider does when reading resource tags.",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:                "tags",
+				MarkdownDescription: "Tags",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f tagsWithoutAWSPrefixFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var tags map[string]string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &tags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, tftags.New(ctx, tags).IgnoreAWS().Map()))
+}
@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestTagsWithoutAWSPrefixFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testTagsWithoutAWSPrefixFunctionConfig(`{ "aws:cloudformation:stack-name" = "example", Name = "example", "awsome" = "true" }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Name":"example","awsome":"true"}`),
+				),
+			},
+		},
+	})
+}
+
+func TestTagsWithoutAWSPrefixFunction_empty(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testTagsWithoutAWSPrefixFunctionConfig(`{}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{}`),
+				),
+			},
+		},
+	})
+}
+
+func testTagsWithoutAWSPrefixFunctionConfig(tags string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = jsonencode(provider::aws::tags_without_aws_prefix(%[1]s))
+}
+`, tags)
+}
@@ -0,0 +1,182 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+var vpcSubnetPlanTierAttrTypes = map[string]attr.Type{
+	"name":  types.StringType,
+	"hosts": types.Int64Type,
+}
+
+var _ function.Function = vpcSubnetPlanFunction{}
+
+func NewVPCSubnetPlanFunction() function.Function {
+	return &vpcSubnetPlanFunction{}
+}
+
+type vpcSubnetPlanFunction struct{}
+
+type vpcSubnetPlanTier struct {
+	Name  string `tfsdk:"name"`
+	Hosts int64  `tfsdk:"hosts"`
+}
+
+func (f vpcSubnetPlanFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "vpc_subnet_plan"
+}
+
+func (f vpcSubnetPlanFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "vpc_subnet_plan Function",
+		MarkdownDescription: "Plans subnet CIDR blocks for each tier in each Availability Zone of a VPC. Each " +
+			"subnet is the smallest CIDR block with at least the requested number of usable host addresses " +
+			"after the 5 addresses reserved by AWS. Subnets are allocated in order, tier by tier.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "vpc_cidr_block",
+				MarkdownDescription: "VPC IPv4 CIDR block",
+			},
+			function.Int64Parameter{
+				Name:                "az_count",
+				MarkdownDescription: "Number of Availability Zones",
+			},
+			function.ListParameter{
+				Name: "tiers",
+				ElementType: types.ObjectType{
+					AttrTypes: vpcSubnetPlanTierAttrTypes,
+				},
+				MarkdownDescription: "Subnet tiers, each with a unique `name` and the minimum number of usable `hosts` per subnet",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.ListType{
+				ElemType: types.StringType,
+			},
+		},
+	}
+}
+
+func (f vpcSubnetPlanFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var vpcCIDRBlock string
+	var azCount int64
+	var tiers []vpcSubnetPlanTier
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &vpcCIDRBlock, &azCount, &tiers))
+	if resp.Error != nil {
+		return
+	}
+
+	vpc, err := parseVPCCIDRBlock(vpcCIDRBlock)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	if azCount < 1 {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, "az_count must be at least 1"))
+		return
+	}
+
+	plan, err := planVPCSubnets(vpc, int(azCount), tiers)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	result, d := types.MapValueFrom(ctx, types.ListType{ElemType: types.StringType}, plan)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// parseVPCCIDRBlock parses and validates a VPC IPv4 CIDR block.
+func parseVPCCIDRBlock(cidr string) (netip.Prefix, error) {
+	if err := inttypes.ValidateIPv4CIDRBlock(cidr); err != nil {
+		return netip.Prefix{}, err
+	}
+
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+
+	if bits := prefix.Bits(); bits < vpcIPv4MinNetmask || bits > vpcIPv4MaxNetmask {
+		return netip.Prefix{}, fmt.Errorf("%q netmask must be between /%d and /%d", cidr, vpcIPv4MinNetmask, vpcIPv4MaxNetmask)
+	}
+
+	return prefix, nil
+}
+
+// subnetNetmaskForHosts returns the netmask of the smallest IPv4 subnet with at least the specified
+// number of usable host addresses.
+func subnetNetmaskForHosts(hosts int64) int {
+	bits := vpcIPv4MaxNetmask
+	for bits > 0 && (int64(1)<<(32-bits))-subnetReservedAddressCount < hosts {
+		bits--
+	}
+
+	return bits
+}
+
+// planVPCSubnets allocates one subnet per tier per Availability Zone from the VPC CIDR block.
+// Subnets are allocated in tier order and then Availability Zone order, each aligned on its own size,
+// so the plan only changes for tiers after one that is modified.
+func planVPCSubnets(vpc netip.Prefix, azCount int, tiers []vpcSubnetPlanTier) (map[string][]string, error) {
+	vpcStart := uint64(binary.BigEndian.Uint32(vpc.Addr().AsSlice()))
+	vpcEnd := vpcStart + uint64(1)<<(32-vpc.Bits())
+
+	plan := make(map[string][]string, len(tiers))
+	next := vpcStart
+
+	for _, tier := range tiers {
+		if tier.Name == "" {
+			return nil, fmt.Errorf("tier name must not be empty")
+		}
+		if _, ok := plan[tier.Name]; ok {
+			return nil, fmt.Errorf("duplicate tier name %q", tier.Name)
+		}
+		if tier.Hosts < 1 {
+			return nil, fmt.Errorf("tier %q: hosts must be at least 1", tier.Name)
+		}
+
+		bits := subnetNetmaskForHosts(tier.Hosts)
+		if bits < vpc.Bits() {
+			return nil, fmt.Errorf("tier %q: %d hosts require a /%d subnet, which is larger than VPC CIDR block %q", tier.Name, tier.Hosts, bits, vpc)
+		}
+
+		size := uint64(1) << (32 - bits)
+		subnets := make([]string, 0, azCount)
+
+		for range azCount {
+			next = (next + size - 1) &^ (size - 1)
+			if next+size > vpcEnd {
+				return nil, fmt.Errorf("tier %q: VPC CIDR block %q does not have enough address space", tier.Name, vpc)
+			}
+
+			var b [4]byte
+			binary.BigEndian.PutUint32(b[:], uint32(next))
+			subnets = append(subnets, netip.PrefixFrom(netip.AddrFrom4(b), bits).String())
+
+			next += size
+		}
+
+		plan[tier.Name] = subnets
+	}
+
+	return plan, nil
+}
@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+var (
+	expectedErrorNotEnoughAddressSpace = regexache.MustCompile(`does[\s\n]*not[\s\n]*have[\s\n]*enough[\s\n]*address[\s\n]*space`)
+	expectedErrorDuplicateTierName     = regexache.MustCompile(`duplicate[\s\n]*tier[\s\n]*name`)
+)
+
+func TestVPCSubnetPlanFunction_basic(t *testing.T) {
+	t.Parallel()
+	tiers := `[
+    { name = "public", hosts = 250 },
+    { name = "private", hosts = 1000 },
+    { name = "database", hosts = 11 },
+  ]`
+	expected := `{"database":["10.0.16.0/28","10.0.16.16/28","10.0.16.32/28"],"private":["10.0.4.0/22","10.0.8.0/22","10.0.12.0/22"],"public":["10.0.0.0/24","10.0.1.0/24","10.0.2.0/24"]}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testVPCSubnetPlanFunctionConfig("10.0.0.0/16", 3, tiers),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", expected),
+				),
+			},
+		},
+	})
+}
+
+func TestVPCSubnetPlanFunction_notEnoughAddressSpace(t *testing.T) {
+	t.Parallel()
+	tiers := `[{ name = "private", hosts = 100 }]`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testVPCSubnetPlanFunctionConfig("10.0.0.0/24", 3, tiers),
+				ExpectError: expectedErrorNotEnoughAddressSpace,
+			},
+		},
+	})
+}
+
+func TestVPCSubnetPlanFunction_duplicateTierName(t *testing.T) {
+	t.Parallel()
+	tiers := `[{ name = "private", hosts = 10 }, { name = "private", hosts = 20 }]`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testVPCSubnetPlanFunctionConfig("10.0.0.0/16", 2, tiers),
+				ExpectError: expectedErrorDuplicateTierName,
+			},
+		},
+	})
+}
+
+func TestVPCSubnetPlanFunction_invalidNetmask(t *testing.T) {
+	t.Parallel()
+	tiers := `[{ name = "private", hosts = 10 }]`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testVPCSubnetPlanFunctionConfig("10.0.0.0/8", 2, tiers),
+				ExpectError: expectedErrorInvalidNetmask,
+			},
+		},
+	})
+}
+
+func testVPCSubnetPlanFunctionConfig(vpcCIDRBlock string, azCount int, tiers string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = jsonencode(provider::aws::vpc_subnet_plan(%[1]q, %[2]d, %[3]s))
+}`, vpcCIDRBlock, azCount, tiers)
+}
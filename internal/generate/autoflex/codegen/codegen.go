@@ -0,0 +1,866 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package codegen generates Go source for AutoFlex expand and flatten functions.
+package codegen
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"go/format"
+	"go/token"
+	"maps"
+	"path"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfreflect "github.com/hashicorp/terraform-provider-aws/internal/reflect"
+)
+
+// CodeGenerator generates Go source for expand and flatten functions that perform
+// the same conversions as flex.Expand and flex.Flatten without run-time reflection.
+//
+// Field matching, `autoflex` struct tags and Options are applied when the
+// source is generated.
+// Conversions that have no static equivalent (for example XML wrappers, types
+// implementing Expander, Flattener or Union, maps and collections of primitives)
+// are delegated to flex.ExpandField, flex.FlattenField, flex.Expand or flex.Flatten so that
+// a generated function always produces the same result as the reflection-based equivalent.
+type CodeGenerator struct {
+	packagePath string
+	packageName string
+	options     Options
+	imports     map[string]string // Import path -> package name.
+	funcs       map[codegenFuncKey]string
+	funcNames   map[string]bool
+	pending     []codegenFunc
+	errs        []error
+}
+
+type codegenFuncKey struct {
+	expand   bool
+	from, to reflect.Type
+}
+
+type codegenFunc struct {
+	codegenFuncKey
+	name string
+}
+
+// Options are the AutoFlex options that generated functions convert with.
+type Options struct {
+	// FieldNamePrefix corresponds to flex.WithFieldNamePrefix.
+	FieldNamePrefix string
+	// FieldNameSuffix corresponds to flex.WithFieldNameSuffix.
+	FieldNameSuffix string
+	// IgnoredFieldNames, if not nil, replaces flex.DefaultIgnoredFieldNames.
+	IgnoredFieldNames []string
+}
+
+// optFns returns the AutoFlexOptionsFuncs corresponding to the options.
+func (o Options) optFns() []fwflex.AutoFlexOptionsFunc {
+	var optFns []fwflex.AutoFlexOptionsFunc
+	if v := o.FieldNamePrefix; v != "" {
+		optFns = append(optFns, fwflex.WithFieldNamePrefix(v))
+	}
+	if v := o.FieldNameSuffix; v != "" {
+		optFns = append(optFns, fwflex.WithFieldNameSuffix(v))
+	}
+	if v := o.IgnoredFieldNames; v != nil {
+		optFns = append(optFns, fwflex.WithIgnoredFieldNames(v))
+	}
+	return optFns
+}
+
+// NewCodeGenerator returns a code generator for functions in the specified package.
+// The options are applied when the source is generated and are passed to any
+// run-time fallbacks.
+func NewCodeGenerator(packagePath, packageName string, options Options) *CodeGenerator {
+	return &CodeGenerator{
+		packagePath: packagePath,
+		packageName: packageName,
+		options:     options,
+		imports:     make(map[string]string),
+		funcs:       make(map[codegenFuncKey]string),
+		funcNames:   make(map[string]bool),
+	}
+}
+
+// Expander adds a function with the signature
+//
+//	func name(ctx context.Context, from TF, to *API) diag.Diagnostics
+//
+// that expands `tfObject`'s type into `apiObject`'s type.
+func (g *CodeGenerator) Expander(name string, tfObject, apiObject any) {
+	g.add(name, true, tfObject, apiObject)
+}
+
+// Flattener adds a function with the signature
+//
+//	func name(ctx context.Context, from API, to *TF) diag.Diagnostics
+//
+// that flattens `apiObject`'s type into `tfObject`'s type.
+func (g *CodeGenerator) Flattener(name string, apiObject, tfObject any) {
+	g.add(name, false, apiObject, tfObject)
+}
+
+func (g *CodeGenerator) add(name string, expand bool, from, to any) {
+	typFrom, typTo := reflect.TypeOf(from), reflect.TypeOf(to)
+	for _, typ := range []*reflect.Type{&typFrom, &typTo} {
+		if *typ != nil && (*typ).Kind() == reflect.Pointer {
+			*typ = (*typ).Elem()
+		}
+	}
+
+	switch {
+	case typFrom == nil || typTo == nil || typFrom.Kind() != reflect.Struct || typTo.Kind() != reflect.Struct:
+		g.errs = append(g.errs, fmt.Errorf("%s: source (%T) and target (%T) must be structs", name, from, to))
+		return
+	case !g.canReference(typFrom) || !g.canReference(typTo):
+		g.errs = append(g.errs, fmt.Errorf("%s: source (%s) and target (%s) must be referenceable from package %s", name, typFrom, typTo, g.packagePath))
+		return
+	case !token.IsIdentifier(name) || g.funcNames[name]:
+		g.errs = append(g.errs, fmt.Errorf("%s: invalid or duplicate function name", name))
+		return
+	}
+
+	key := codegenFuncKey{expand: expand, from: typFrom, to: typTo}
+	if _, ok := g.funcs[key]; !ok {
+		g.funcs[key] = name
+	}
+	g.funcNames[name] = true
+	g.pending = append(g.pending, codegenFunc{codegenFuncKey: key, name: name})
+}
+
+// Source returns the formatted Go source for all added functions and the
+// functions that they call.
+func (g *CodeGenerator) Source() ([]byte, error) {
+	if len(g.errs) > 0 {
+		return nil, g.errs[0]
+	}
+
+	var body bytes.Buffer
+	for len(g.pending) > 0 {
+		fn := g.pending[0]
+		g.pending = g.pending[1:]
+
+		body.WriteString("\n")
+		if fn.expand {
+			g.writeExpandFunc(&body, fn)
+		} else {
+			g.writeFlattenFunc(&body, fn)
+		}
+	}
+
+	var src bytes.Buffer
+	src.WriteString("// Copyright IBM Corp. 2014, 2026\n")
+	src.WriteString("// SPDX-License-Identifier: MPL-2.0\n\n")
+	src.WriteString("// Code generated by internal/generate/autoflex/main.go; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&src, "package %s\n\n", g.packageName)
+	g.writeImports(&src)
+	src.Write(body.Bytes())
+
+	return format.Source(src.Bytes())
+}
+
+func (g *CodeGenerator) writeImports(w *bytes.Buffer) {
+	var std, other []string
+	for importPath, name := range g.imports {
+		spec := fmt.Sprintf("%q", importPath)
+		if name != path.Base(importPath) {
+			spec = name + " " + spec
+		}
+		if first, _, _ := strings.Cut(importPath, "/"); strings.Contains(first, ".") {
+			other = append(other, spec)
+		} else {
+			std = append(std, spec)
+		}
+	}
+	slices.SortFunc(std, compareImportSpecs)
+	slices.SortFunc(other, compareImportSpecs)
+
+	w.WriteString("import (\n")
+	for _, spec := range std {
+		fmt.Fprintf(w, "%s\n", spec)
+	}
+	if len(std) > 0 && len(other) > 0 {
+		w.WriteString("\n")
+	}
+	for _, spec := range other {
+		fmt.Fprintf(w, "%s\n", spec)
+	}
+	w.WriteString(")\n")
+}
+
+// compareImportSpecs orders import specs by path.
+func compareImportSpecs(a, b string) int {
+	pathOf := func(s string) string {
+		_, after, _ := strings.Cut(s, `"`)
+		return after
+	}
+	return strings.Compare(pathOf(a), pathOf(b))
+}
+
+func (g *CodeGenerator) writeExpandFunc(w *bytes.Buffer, fn codegenFunc) {
+	g.writeFuncSignature(w, fn)
+
+	if !g.canGenerate(fn.from, fn.to) {
+		fmt.Fprintf(w, "return %sExpand(ctx, from, to%s)\n}\n", g.qualifier(flexPackagePath), g.optionsExpr())
+		return
+	}
+
+	fmt.Fprintf(w, "var diags %sDiagnostics\n\n", g.qualifier(diagPackagePath))
+
+	for _, pair := range fwflex.ExpandFieldPairs(fn.from, fn.to, g.options.optFns()...) {
+		fromField, toField := pair.From, pair.To
+		from, to := "from."+fromField.Name, "to."+toField.Name
+		if stmts, ok := g.expandField(fn.name+fromField.Name, from, fromField.Type, to, toField.Type); ok {
+			w.WriteString(stmts)
+		} else {
+			fmt.Fprintf(w, "diags.Append(%sExpandField(ctx, &%s, &%s%s)...)\n", g.qualifier(flexPackagePath), from, to, g.optionsExpr())
+			w.WriteString(returnOnError)
+		}
+		w.WriteString("\n")
+	}
+
+	w.WriteString("return diags\n}\n")
+}
+
+func (g *CodeGenerator) writeFlattenFunc(w *bytes.Buffer, fn codegenFunc) {
+	g.writeFuncSignature(w, fn)
+
+	if !g.canGenerate(fn.to, fn.from) {
+		fmt.Fprintf(w, "return %sFlatten(ctx, from, to%s)\n}\n", g.qualifier(flexPackagePath), g.optionsExpr())
+		return
+	}
+
+	fmt.Fprintf(w, "var diags %sDiagnostics\n\n", g.qualifier(diagPackagePath))
+
+	for _, pair := range fwflex.FlattenFieldPairs(fn.from, fn.to, g.options.optFns()...) {
+		fromField, toField := pair.From, pair.To
+		from, to := "from."+fromField.Name, "to."+toField.Name
+		if stmts, ok := g.flattenField(fn.name+toField.Name, from, fromField.Type, to, toField.Type); ok {
+			w.WriteString(stmts)
+		} else {
+			fmt.Fprintf(w, "diags.Append(%sFlattenField(ctx, &%s, &%s%s)...)\n", g.qualifier(flexPackagePath), from, to, g.optionsExpr())
+			w.WriteString(returnOnError)
+		}
+		w.WriteString("\n")
+	}
+
+	w.WriteString("return diags\n}\n")
+}
+
+func (g *CodeGenerator) writeFuncSignature(w *bytes.Buffer, fn codegenFunc) {
+	fmt.Fprintf(w, "func %s(ctx %sContext, from %s, to *%s) %sDiagnostics {\n", fn.name, g.qualifier("context"), g.typeExpr(fn.from), g.typeExpr(fn.to), g.qualifier(diagPackagePath))
+}
+
+const returnOnError = "if diags.HasError() {\nreturn diags\n}\n"
+
+// expandField returns statements that expand the model field `from` into the API field `to`.
+// If the conversion can't be generated, false is returned.
+func (g *CodeGenerator) expandField(funcName, from string, typFrom reflect.Type, to string, typTo reflect.Type) (string, bool) {
+	if typFrom.Kind() != reflect.Struct || !typFrom.Implements(reflect.TypeFor[attr.Value]()) {
+		return "", false
+	}
+	for _, typ := range []reflect.Type{reflect.TypeFor[fwflex.Expander](), reflect.TypeFor[fwflex.TypedExpander](), reflect.TypeFor[fwflex.Union]()} {
+		if typFrom.Implements(typ) {
+			return "", false
+		}
+	}
+
+	var w bytes.Buffer
+	valuable := func(typ reflect.Type, method string) string {
+		if typFrom == typ {
+			return from
+		}
+		fmt.Fprintf(&w, "v, d := %s.%s(ctx)\ndiags.Append(d...)\n%s", from, method, returnOnError)
+		return "v"
+	}
+
+	switch {
+	case typFrom.Implements(reflect.TypeFor[basetypes.BoolValuable]()):
+		switch {
+		case typTo.Kind() == reflect.Bool:
+			v := valuable(reflect.TypeFor[basetypes.BoolValue](), "ToBoolValue")
+			fmt.Fprintf(&w, "%s = %s\n", to, g.convertExpr(typTo, reflect.TypeFor[bool](), v+".ValueBool()"))
+		case isPointerTo(typTo, reflect.TypeFor[bool]()):
+			v := valuable(reflect.TypeFor[basetypes.BoolValue](), "ToBoolValue")
+			fmt.Fprintf(&w, "%s = %s.ValueBoolPointer()\n", to, v)
+		default:
+			return "", false
+		}
+
+	case typFrom.Implements(reflect.TypeFor[basetypes.Float64Valuable]()):
+		switch {
+		case typTo.Kind() == reflect.Float32 || typTo.Kind() == reflect.Float64:
+			v := valuable(reflect.TypeFor[basetypes.Float64Value](), "ToFloat64Value")
+			fmt.Fprintf(&w, "%s = %s\n", to, g.convertExpr(typTo, reflect.TypeFor[float64](), v+".ValueFloat64()"))
+		case isPointerTo(typTo, reflect.TypeFor[float32]()):
+			v := valuable(reflect.TypeFor[basetypes.Float64Value](), "ToFloat64Value")
+			fmt.Fprintf(&w, "f := float32(%s.ValueFloat64())\n%s = &f\n", v, to)
+		case isPointerTo(typTo, reflect.TypeFor[float64]()):
+			v := valuable(reflect.TypeFor[basetypes.Float64Value](), "ToFloat64Value")
+			fmt.Fprintf(&w, "%s = %s.ValueFloat64Pointer()\n", to, v)
+		default:
+			return "", false
+		}
+
+	case typFrom.Implements(reflect.TypeFor[basetypes.Float32Valuable]()):
+		switch {
+		case typTo.Kind() == reflect.Float32:
+			v := valuable(reflect.TypeFor[basetypes.Float32Value](), "ToFloat32Value")
+			fmt.Fprintf(&w, "%s = %s\n", to, g.convertExpr(typTo, reflect.TypeFor[float32](), v+".ValueFloat32()"))
+		case isPointerTo(typTo, reflect.TypeFor[float32]()):
+			v := valuable(reflect.TypeFor[basetypes.Float32Value](), "ToFloat32Value")
+			fmt.Fprintf(&w, "%s = %s.ValueFloat32Pointer()\n", to, v)
+		default:
+			return "", false
+		}
+
+	case typFrom.Implements(reflect.TypeFor[basetypes.Int64Valuable]()):
+		switch {
+		case typTo.Kind() == reflect.Int32 || typTo.Kind() == reflect.Int64:
+			v := valuable(reflect.TypeFor[basetypes.Int64Value](), "ToInt64Value")
+			fmt.Fprintf(&w, "%s = %s\n", to, g.convertExpr(typTo, reflect.TypeFor[int64](), v+".ValueInt64()"))
+		case isPointerTo(typTo, reflect.TypeFor[int32]()):
+			v := valuable(reflect.TypeFor[basetypes.Int64Value](), "ToInt64Value")
+			fmt.Fprintf(&w, "i := int32(%s.ValueInt64())\n%s = &i\n", v, to)
+		case isPointerTo(typTo, reflect.TypeFor[int64]()):
+			v := valuable(reflect.TypeFor[basetypes.Int64Value](), "ToInt64Value")
+			fmt.Fprintf(&w, "%s = %s.ValueInt64Pointer()\n", to, v)
+		default:
+			return "", false
+		}
+
+	case typFrom.Implements(reflect.TypeFor[basetypes.Int32Valuable]()):
+		switch {
+		case typTo.Kind() == reflect.Int32:
+			v := valuable(reflect.TypeFor[basetypes.Int32Value](), "ToInt32Value")
+			fmt.Fprintf(&w, "%s = %s\n", to, g.convertExpr(typTo, reflect.TypeFor[int32](), v+".ValueInt32()"))
+		case isPointerTo(typTo, reflect.TypeFor[int32]()):
+			v := valuable(reflect.TypeFor[basetypes.Int32Value](), "ToInt32Value")
+			fmt.Fprintf(&w, "%s = %s.ValueInt32Pointer()\n", to, v)
+		default:
+			return "", false
+		}
+
+	case typFrom.Implements(reflect.TypeFor[basetypes.StringValuable]()):
+		switch {
+		case typTo.Kind() == reflect.String:
+			v := valuable(reflect.TypeFor[basetypes.StringValue](), "ToStringValue")
+			fmt.Fprintf(&w, "%s = %s\n", to, g.convertExpr(typTo, reflect.TypeFor[string](), v+".ValueString()"))
+		case typTo == reflect.TypeFor[[]byte]():
+			v := valuable(reflect.TypeFor[basetypes.StringValue](), "ToStringValue")
+			fmt.Fprintf(&w, "%s = []byte(%s.ValueString())\n", to, v)
+		case isPointerTo(typTo, reflect.TypeFor[string]()):
+			v := valuable(reflect.TypeFor[basetypes.StringValue](), "ToStringValue")
+			fmt.Fprintf(&w, "%s = %s.ValueStringPointer()\n", to, v)
+		case typFrom == reflect.TypeFor[timetypes.RFC3339]() && typTo == reflect.TypeFor[time.Time]():
+			fmt.Fprintf(&w, "t, d := %s.ValueRFC3339Time()\ndiags.Append(d...)\n%s%s = t\n", from, returnOnError, to)
+		case typFrom == reflect.TypeFor[timetypes.RFC3339]() && isPointerTo(typTo, reflect.TypeFor[time.Time]()):
+			fmt.Fprintf(&w, "t, d := %s.ValueRFC3339Time()\ndiags.Append(d...)\n%s%s = &t\n", from, returnOnError, to)
+		default:
+			return "", false
+		}
+
+	default:
+		kind, typElem, ok := nestedObjectValueOf(typFrom)
+		if !ok {
+			return "", false
+		}
+
+		switch {
+		case typTo.Kind() == reflect.Struct, typTo.Kind() == reflect.Pointer && typTo.Name() == "" && typTo.Elem().Kind() == reflect.Struct:
+			typStruct := typTo
+			if typTo.Kind() == reflect.Pointer {
+				typStruct = typTo.Elem()
+			}
+			nested, ok := g.nestedFunc(funcName, true, typElem, typStruct)
+			if !ok {
+				return "", false
+			}
+			fmt.Fprintf(&w, "ptr, d := %s.ToPtr(ctx)\ndiags.Append(d...)\n%s", from, returnOnError)
+			fmt.Fprintf(&w, "var v %s\nif ptr != nil {\ndiags.Append(%s(ctx, *ptr, &v)...)\n%s}\n", g.typeExpr(typStruct), nested, returnOnError)
+			if typTo.Kind() == reflect.Pointer {
+				fmt.Fprintf(&w, "%s = &v\n", to)
+			} else {
+				fmt.Fprintf(&w, "%s = v\n", to)
+			}
+
+		case kind != nestedObjectKindObject && typTo.Kind() == reflect.Slice:
+			typStruct, isPointer := typTo.Elem(), false
+			if typStruct.Kind() == reflect.Pointer && typStruct.Name() == "" {
+				typStruct, isPointer = typStruct.Elem(), true
+			}
+			if typStruct.Kind() != reflect.Struct {
+				return "", false
+			}
+			nested, ok := g.nestedFunc(funcName, true, typElem, typStruct)
+			if !ok {
+				return "", false
+			}
+			fmt.Fprintf(&w, "s, d := %s.ToSlice(ctx)\ndiags.Append(d...)\n%s", from, returnOnError)
+			fmt.Fprintf(&w, "v := make(%s, len(s))\nfor i, e := range s {\n", g.typeExpr(typTo))
+			if isPointer {
+				fmt.Fprintf(&w, "v[i] = new(%s)\ndiags.Append(%s(ctx, *e, v[i])...)\n", g.typeExpr(typStruct), nested)
+			} else {
+				fmt.Fprintf(&w, "diags.Append(%s(ctx, *e, &v[i])...)\n", nested)
+			}
+			fmt.Fprintf(&w, "%s}\n%s = v\n", returnOnError, to)
+
+		default:
+			return "", false
+		}
+	}
+
+	return fmt.Sprintf("if !%[1]s.IsNull() && !%[1]s.IsUnknown() {\n%[2]s}\n", from, w.String()), true
+}
+
+// flattenField returns statements that flatten the API field `from` into the model field `to`.
+// If the conversion can't be generated, false is returned.
+func (g *CodeGenerator) flattenField(funcName, from string, typFrom reflect.Type, to string, typTo reflect.Type) (string, bool) {
+	attrTyp, ok := attrTypeOf(typTo)
+	if !ok {
+		return "", false
+	}
+
+	typValue, isPointer := typFrom, false
+	if typFrom.Kind() == reflect.Pointer && typFrom.Name() == "" {
+		typValue, isPointer = typFrom.Elem(), true
+	}
+
+	var w bytes.Buffer
+	// primitive writes statements that set `to` from the specified basetypes value.
+	primitive := func(typBase reflect.Type, typable, kind string, typGo reflect.Type, enum bool) {
+		types := g.qualifier(typesPackagePath)
+		value := from
+		if isPointer {
+			value = "*" + from
+		}
+		if typValue != typGo {
+			value = fmt.Sprintf("%s(%s)", typGo, value)
+		}
+		value = fmt.Sprintf("%s%sValue(%s)", types, kind, value)
+
+		switch cond := g.nonEmptyCond(from, isPointer, enum); {
+		case cond == "" && typTo == typBase:
+			fmt.Fprintf(&w, "%s = %s\n", to, value)
+			return
+		case cond == "":
+			fmt.Fprintf(&w, "v := %s\n", value)
+		default:
+			fmt.Fprintf(&w, "v := %s%sNull()\nif %s {\nv = %s\n}\n", types, kind, cond, value)
+		}
+
+		if typTo == typBase {
+			fmt.Fprintf(&w, "%s = v\n", to)
+			return
+		}
+		fmt.Fprintf(&w, "t, d := %s.Type(ctx).(%s%s).ValueFrom%s(ctx, v)\ndiags.Append(d...)\n%s", to, g.qualifier(basetypesPackagePath), typable, kind, returnOnError)
+		fmt.Fprintf(&w, "%s = t.(%s)\n", to, g.typeExpr(typTo))
+	}
+
+	switch typValue.Kind() {
+	case reflect.Bool:
+		if _, ok := attrTyp.(basetypes.BoolTypable); !ok {
+			return "", false
+		}
+		primitive(reflect.TypeFor[basetypes.BoolValue](), "BoolTypable", "Bool", reflect.TypeFor[bool](), false)
+
+	case reflect.Float64:
+		if _, ok := attrTyp.(basetypes.Float64Typable); !ok {
+			return "", false
+		}
+		primitive(reflect.TypeFor[basetypes.Float64Value](), "Float64Typable", "Float64", reflect.TypeFor[float64](), false)
+
+	case reflect.Int64:
+		if _, ok := attrTyp.(basetypes.Int64Typable); !ok {
+			return "", false
+		}
+		primitive(reflect.TypeFor[basetypes.Int64Value](), "Int64Typable", "Int64", reflect.TypeFor[int64](), false)
+
+	case reflect.Int32:
+		switch attrTyp.(type) {
+		case basetypes.Int64Typable:
+			primitive(reflect.TypeFor[basetypes.Int64Value](), "Int64Typable", "Int64", reflect.TypeFor[int64](), false)
+		case basetypes.Int32Typable:
+			primitive(reflect.TypeFor[basetypes.Int32Value](), "Int32Typable", "Int32", reflect.TypeFor[int32](), false)
+		default:
+			return "", false
+		}
+
+	case reflect.String:
+		attrTyp, ok := attrTyp.(basetypes.StringTypable)
+		if !ok {
+			return "", false
+		}
+		primitive(reflect.TypeFor[basetypes.StringValue](), "StringTypable", "String", reflect.TypeFor[string](), strings.HasPrefix(attrTyp.String(), "StringEnumType["))
+
+	case reflect.Struct:
+		if typValue == reflect.TypeFor[time.Time]() && typTo == reflect.TypeFor[timetypes.RFC3339]() {
+			q := g.qualifier(timetypesPackagePath)
+			if isPointer {
+				fmt.Fprintf(&w, "if %s == nil {\n%s = %sNewRFC3339Null()\n} else {\n%s = %sNewRFC3339TimeValue(*%s)\n}\n", from, to, q, to, q, from)
+			} else {
+				fmt.Fprintf(&w, "%s = %sNewRFC3339TimeValue(%s)\n", to, q, from)
+			}
+			break
+		}
+
+		if _, ok := attrTyp.(fwtypes.NestedObjectType); !ok {
+			return "", false
+		}
+		kind, typElem, ok := nestedObjectValueOf(typTo)
+		if !ok {
+			return "", false
+		}
+		nested, ok := g.nestedFunc(funcName, false, typValue, typElem)
+		if !ok {
+			return "", false
+		}
+
+		q, elem := g.qualifier(fwtypesPackagePath), g.typeExpr(typElem)
+		value := from
+		if isPointer {
+			value = "*" + from
+			fmt.Fprintf(&w, "if %s == nil {\n%s = %sNew%sNull[%s](ctx)\n} else {\n", from, to, q, kind.valueOf(), elem)
+		} else {
+			w.WriteString("{\n")
+		}
+		fmt.Fprintf(&w, "var v %s\ndiags.Append(%s(ctx, %s, &v)...)\n%s", elem, nested, value, returnOnError)
+		if kind == nestedObjectKindObject {
+			fmt.Fprintf(&w, "t, d := %sNewObjectValueOf(ctx, &v)\n", q)
+		} else {
+			fmt.Fprintf(&w, "t, d := %sNew%sPtr(ctx, &v)\n", q, kind.valueOf())
+		}
+		fmt.Fprintf(&w, "diags.Append(d...)\n%s%s = t\n}\n", returnOnError, to)
+
+	case reflect.Slice:
+		if isPointer {
+			return "", false
+		}
+		if _, ok := attrTyp.(fwtypes.NestedObjectCollectionType); !ok {
+			return "", false
+		}
+		kind, typElem, ok := nestedObjectValueOf(typTo)
+		if !ok || kind == nestedObjectKindObject {
+			return "", false
+		}
+		typStruct, isPointerElem := typValue.Elem(), false
+		if typStruct.Kind() == reflect.Pointer && typStruct.Name() == "" {
+			typStruct, isPointerElem = typStruct.Elem(), true
+		}
+		if typStruct.Kind() != reflect.Struct {
+			return "", false
+		}
+		nested, ok := g.nestedFunc(funcName, false, typStruct, typElem)
+		if !ok {
+			return "", false
+		}
+
+		q, elem := g.qualifier(fwtypesPackagePath), g.typeExpr(typElem)
+		value := "e"
+		if isPointerElem {
+			value = "*e"
+		}
+		fmt.Fprintf(&w, "if %s == nil {\n%s = %sNew%sNull[%s](ctx)\n} else {\n", from, to, q, kind.valueOf(), elem)
+		fmt.Fprintf(&w, "s := make([]*%s, len(%s))\nfor i, e := range %s {\ns[i] = new(%s)\n", elem, from, from, elem)
+		fmt.Fprintf(&w, "diags.Append(%s(ctx, %s, s[i])...)\n%s}\n", nested, value, returnOnError)
+		fmt.Fprintf(&w, "t, d := %sNew%sSlice(ctx, s, nil)\ndiags.Append(d...)\n%s%s = t\n}\n", q, kind.valueOf(), returnOnError, to)
+
+	default:
+		return "", false
+	}
+
+	stmts := w.String()
+	if strings.Count(stmts, "\n") > 1 && !strings.HasPrefix(stmts, "if ") && !strings.HasPrefix(stmts, "{") {
+		stmts = "{\n" + stmts + "}\n"
+	}
+
+	return stmts, true
+}
+
+// nonEmptyCond returns the condition under which a flattened primitive value is not null.
+func (g *CodeGenerator) nonEmptyCond(from string, isPointer, enum bool) string {
+	switch {
+	case isPointer && enum:
+		return fmt.Sprintf(`%s != nil && *%s != ""`, from, from)
+	case isPointer:
+		return from + " != nil"
+	case enum:
+		return from + ` != ""`
+	default:
+		return ""
+	}
+}
+
+// nestedFunc returns the name of the function that converts between the specified struct types,
+// adding the function if necessary.
+// If the conversion can't be generated, false is returned.
+func (g *CodeGenerator) nestedFunc(name string, expand bool, from, to reflect.Type) (string, bool) {
+	key := codegenFuncKey{expand: expand, from: from, to: to}
+	if name, ok := g.funcs[key]; ok {
+		return name, true
+	}
+
+	if expand && !g.canGenerate(from, to) || !expand && !g.canGenerate(to, from) {
+		return "", false
+	}
+
+	for i, base := 2, name; g.funcNames[name]; i++ {
+		name = fmt.Sprintf("%s%d", base, i)
+	}
+	g.funcs[key] = name
+	g.funcNames[name] = true
+	g.pending = append(g.pending, codegenFunc{codegenFuncKey: key, name: name})
+
+	return name, true
+}
+
+// canGenerate returns whether field-by-field conversion between the specified model and API struct types can be generated.
+// Types that are converted in ways that depend on run-time values are handled by Expand and Flatten.
+func (g *CodeGenerator) canGenerate(typModel, typAPI reflect.Type) bool {
+	if typModel.Kind() != reflect.Struct || typAPI.Kind() != reflect.Struct || !g.canReference(typModel) || !g.canReference(typAPI) {
+		return false
+	}
+
+	for _, typ := range []reflect.Type{
+		reflect.TypeFor[fwflex.Expander](),
+		reflect.TypeFor[fwflex.TypedExpander](),
+		reflect.TypeFor[fwflex.Flattener](),
+		reflect.TypeFor[fwflex.Union](),
+		reflect.TypeFor[basetypes.ListValuable](),
+		reflect.TypeFor[basetypes.SetValuable](),
+	} {
+		if typModel.Implements(typ) || reflect.PointerTo(typModel).Implements(typ) {
+			return false
+		}
+	}
+
+	if fwflex.RequiresReflection(typModel, typAPI, g.options.optFns()...) {
+		return false
+	}
+
+	// Fields promoted through embedded pointers can't be safely selected.
+	for _, typ := range []reflect.Type{typModel, typAPI} {
+		for field := range tfreflect.ExportedStructFields(typ) {
+			for i := range len(field.Index) - 1 {
+				if typ.FieldByIndex(field.Index[:i+1]).Type.Kind() == reflect.Pointer {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// canReference returns whether the specified named type can be referenced from the generated package.
+func (g *CodeGenerator) canReference(typ reflect.Type) bool {
+	name, _, _ := strings.Cut(typ.Name(), "[")
+	return name != "" && (typ.PkgPath() == g.packagePath || token.IsExported(name))
+}
+
+// optionsExpr returns the flex.AutoFlexOptionsFunc arguments, including the leading comma, that reproduce the generator's options.
+func (g *CodeGenerator) optionsExpr() string {
+	var sb strings.Builder
+
+	if v := g.options.FieldNamePrefix; v != "" {
+		fmt.Fprintf(&sb, ", %sWithFieldNamePrefix(%q)", g.qualifier(flexPackagePath), v)
+	}
+	if v := g.options.FieldNameSuffix; v != "" {
+		fmt.Fprintf(&sb, ", %sWithFieldNameSuffix(%q)", g.qualifier(flexPackagePath), v)
+	}
+	switch v := g.options.IgnoredFieldNames; {
+	case v == nil, slices.Equal(v, fwflex.DefaultIgnoredFieldNames):
+	case len(v) == 0:
+		fmt.Fprintf(&sb, ", %sWithNoIgnoredFieldNames()", g.qualifier(flexPackagePath))
+	default:
+		quoted := make([]string, 0, len(v))
+		for _, s := range v {
+			quoted = append(quoted, fmt.Sprintf("%q", s))
+		}
+		fmt.Fprintf(&sb, ", %sWithIgnoredFieldNames([]string{%s})", g.qualifier(flexPackagePath), strings.Join(quoted, ", "))
+	}
+
+	return sb.String()
+}
+
+// convertExpr returns `expr`, of type `typExpr`, converted to the specified type.
+func (g *CodeGenerator) convertExpr(typ, typExpr reflect.Type, expr string) string {
+	if typ == typExpr {
+		return expr
+	}
+	return fmt.Sprintf("%s(%s)", g.typeExpr(typ), expr)
+}
+
+var codegenQualifiedNameRegexp = regexache.MustCompile(`[\w\-~./]+\.\w+`)
+
+// typeExpr returns the Go expression for the specified type.
+func (g *CodeGenerator) typeExpr(typ reflect.Type) string {
+	if name := typ.Name(); name != "" {
+		if typ.PkgPath() == "" {
+			return name
+		}
+
+		if i := strings.IndexByte(name, '['); i >= 0 {
+			name = name[:i] + codegenQualifiedNameRegexp.ReplaceAllStringFunc(name[i:], func(s string) string {
+				i := strings.LastIndexByte(s, '.')
+				return g.qualifier(s[:i]) + s[i+1:]
+			})
+		}
+
+		return g.qualifier(typ.PkgPath()) + name
+	}
+
+	switch typ.Kind() {
+	case reflect.Array:
+		return fmt.Sprintf("[%d]%s", typ.Len(), g.typeExpr(typ.Elem()))
+	case reflect.Map:
+		return fmt.Sprintf("map[%s]%s", g.typeExpr(typ.Key()), g.typeExpr(typ.Elem()))
+	case reflect.Pointer:
+		return "*" + g.typeExpr(typ.Elem())
+	case reflect.Slice:
+		return "[]" + g.typeExpr(typ.Elem())
+	default:
+		return typ.String()
+	}
+}
+
+// qualifier returns the package qualifier, including the trailing period, for the specified import path.
+func (g *CodeGenerator) qualifier(importPath string) string {
+	if importPath == g.packagePath {
+		return ""
+	}
+
+	name, ok := g.imports[importPath]
+	if !ok {
+		base := codegenPackageName(importPath)
+		name = base
+		for i := 2; slices.Contains(slices.Collect(maps.Values(g.imports)), name); i++ {
+			name = fmt.Sprintf("%s%d", base, i)
+		}
+		g.imports[importPath] = name
+	}
+
+	return name + "."
+}
+
+const (
+	awsSDKServicePathPrefix = "github.com/aws/aws-sdk-go-v2/service/"
+	basetypesPackagePath    = "github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	diagPackagePath         = "github.com/hashicorp/terraform-plugin-framework/diag"
+	timetypesPackagePath    = "github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	typesPackagePath        = "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	flexPackagePath    = reflect.TypeFor[fwflex.AutoFlexOptions]().PkgPath()
+	fwtypesPackagePath = reflect.TypeFor[fwtypes.ARN]().PkgPath()
+)
+
+// codegenPackageName returns the name used for the package with the specified import path.
+func codegenPackageName(importPath string) string {
+	switch {
+	case importPath == flexPackagePath:
+		return "fwflex"
+	case importPath == fwtypesPackagePath:
+		return "fwtypes"
+	case strings.HasPrefix(importPath, awsSDKServicePathPrefix) && strings.HasSuffix(importPath, "/types"):
+		return "awstypes"
+	}
+
+	elems := strings.Split(importPath, "/")
+	name := elems[len(elems)-1]
+	if len(elems) > 1 && regexache.MustCompile(`^v[0-9]+$`).MatchString(name) {
+		name = elems[len(elems)-2]
+	}
+
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == '.' {
+			return -1
+		}
+		return r
+	}, name)
+}
+
+type nestedObjectKind int
+
+const (
+	nestedObjectKindList nestedObjectKind = iota
+	nestedObjectKindSet
+	nestedObjectKindObject
+)
+
+// valueOf returns the name of the kind's fwtypes value type without type arguments.
+func (k nestedObjectKind) valueOf() string {
+	switch k {
+	case nestedObjectKindList:
+		return "ListNestedObjectValueOf"
+	case nestedObjectKindSet:
+		return "SetNestedObjectValueOf"
+	default:
+		return "ObjectValueOf"
+	}
+}
+
+// nestedObjectValueOf returns the kind and element type of an fwtypes nested object value type.
+func nestedObjectValueOf(typ reflect.Type) (nestedObjectKind, reflect.Type, bool) {
+	if typ.PkgPath() != fwtypesPackagePath {
+		return 0, nil, false
+	}
+
+	for _, kind := range []nestedObjectKind{nestedObjectKindList, nestedObjectKindSet, nestedObjectKindObject} {
+		if !strings.HasPrefix(typ.Name(), kind.valueOf()+"[") {
+			continue
+		}
+
+		v, ok := reflect.Zero(typ).Interface().(fwtypes.NestedObjectValue)
+		if !ok {
+			return 0, nil, false
+		}
+		t, ok := v.Type(context.Background()).(fwtypes.NestedObjectType)
+		if !ok {
+			return 0, nil, false
+		}
+		ptr, diags := t.NewObjectPtr(context.Background())
+		if diags.HasError() {
+			return 0, nil, false
+		}
+
+		return kind, reflect.TypeOf(ptr).Elem(), true
+	}
+
+	return 0, nil, false
+}
+
+// attrTypeOf returns the attr.Type of the specified attr.Value type.
+func attrTypeOf(typ reflect.Type) (attrTyp attr.Type, ok bool) {
+	v, ok := reflect.Zero(typ).Interface().(attr.Value)
+	if !ok {
+		return nil, false
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			attrTyp, ok = nil, false
+		}
+	}()
+
+	return v.Type(context.Background()), true
+}
+
+// isPointerTo returns whether `typ` is an unnamed pointer to `elem`.
+func isPointerTo(typ, elem reflect.Type) bool {
+	return typ.Kind() == reflect.Pointer && typ.Name() == "" && typ.Elem() == elem
+}
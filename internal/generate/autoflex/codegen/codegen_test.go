@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package codegen
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+// The generator's output is checked against flex.Expand and flex.Flatten by the
+// AutoFlex golden snapshot tests in internal/framework/flex.
+
+var codegenPackagePath = reflect.TypeFor[CodeGenerator]().PkgPath()
+
+type tfSingleStringField struct {
+	Field1 types.String `tfsdk:"field1"`
+}
+
+type awsSingleStringValue struct {
+	Field1 string
+}
+
+type tfListOfString struct {
+	Field1 types.List `tfsdk:"field1"`
+}
+
+type awsSliceOfString struct {
+	Field1 []string
+}
+
+type tfFlexer struct {
+	Field1 types.String `tfsdk:"field1"`
+}
+
+var (
+	_ fwflex.Expander  = tfFlexer{}
+	_ fwflex.Flattener = &tfFlexer{}
+)
+
+func (t tfFlexer) Expand(ctx context.Context) (any, diag.Diagnostics) {
+	return &awsSingleStringValue{Field1: t.Field1.ValueString()}, nil
+}
+
+func (t *tfFlexer) Flatten(ctx context.Context, v any) diag.Diagnostics {
+	if v, ok := v.(awsSingleStringValue); ok {
+		t.Field1 = types.StringValue(v.Field1)
+	}
+	return nil
+}
+
+func TestCodeGenerator(t *testing.T) {
+	t.Parallel()
+
+	g := NewCodeGenerator(codegenPackagePath, "codegen", Options{})
+	g.Expander("expandSingleStringField", tfSingleStringField{}, awsSingleStringValue{})
+	g.Flattener("flattenSingleStringValue", awsSingleStringValue{}, tfSingleStringField{})
+
+	got, err := g.Source()
+	if err != nil {
+		t.Fatalf("generating source: %s", err)
+	}
+
+	for _, want := range []string{
+		"// Code generated by internal/generate/autoflex/main.go; DO NOT EDIT.",
+		"func expandSingleStringField(ctx context.Context, from tfSingleStringField, to *awsSingleStringValue) diag.Diagnostics {",
+		"to.Field1 = from.Field1.ValueString()",
+		"func flattenSingleStringValue(ctx context.Context, from awsSingleStringValue, to *tfSingleStringField) diag.Diagnostics {",
+		"to.Field1 = types.StringValue(from.Field1)",
+	} {
+		if !bytes.Contains(got, []byte(want)) {
+			t.Errorf("expected generated source to contain %q\n%s", want, got)
+		}
+	}
+}
+
+func TestCodeGeneratorErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]func(*CodeGenerator){
+		"non-struct source": func(g *CodeGenerator) {
+			g.Expander("expandString", "", awsSingleStringValue{})
+		},
+		"nil target": func(g *CodeGenerator) {
+			g.Flattener("flattenNil", awsSingleStringValue{}, nil)
+		},
+		"invalid name": func(g *CodeGenerator) {
+			g.Expander("expand-string", tfSingleStringField{}, awsSingleStringValue{})
+		},
+		"anonymous struct": func(g *CodeGenerator) {
+			g.Expander("expandAnonymous", struct{ Field1 types.String }{}, awsSingleStringValue{})
+		},
+		"duplicate name": func(g *CodeGenerator) {
+			g.Expander("expandString", tfSingleStringField{}, awsSingleStringValue{})
+			g.Flattener("expandString", awsSingleStringValue{}, tfSingleStringField{})
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			t.Parallel()
+
+			g := NewCodeGenerator(codegenPackagePath, "codegen", Options{})
+			testCase(g)
+
+			if _, err := g.Source(); err == nil {
+				t.Error("expected error, got none")
+			}
+		})
+	}
+}
+
+func TestCodeGeneratorOptions(t *testing.T) {
+	t.Parallel()
+
+	g := NewCodeGenerator(codegenPackagePath, "codegen", Options{FieldNamePrefix: "Intent", IgnoredFieldNames: []string{}})
+	g.Expander("expandListOfString", tfListOfString{}, awsSliceOfString{})
+	g.Flattener("flattenFlexer", awsSingleStringValue{}, tfFlexer{})
+
+	got, err := g.Source()
+	if err != nil {
+		t.Fatalf("generating source: %s", err)
+	}
+
+	for _, want := range []string{
+		`fwflex.ExpandField(ctx, &from.Field1, &to.Field1, fwflex.WithFieldNamePrefix("Intent"), fwflex.WithNoIgnoredFieldNames())`,
+		`return fwflex.Flatten(ctx, from, to, fwflex.WithFieldNamePrefix("Intent"), fwflex.WithNoIgnoredFieldNames())`,
+	} {
+		if !bytes.Contains(got, []byte(want)) {
+			t.Errorf("expected generated source to contain %q\n%s", want, got)
+		}
+	}
+}
+
+func TestCodegenPackageName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		flexPackagePath:    "fwflex",
+		fwtypesPackagePath: "fwtypes",
+		"github.com/aws/aws-sdk-go-v2/service/s3/types":     "awstypes",
+		"github.com/aws/aws-sdk-go-v2/service/s3":           "s3",
+		"github.com/hashicorp/terraform-plugin-go/v2":       "terraformplugingo",
+		"github.com/hashicorp/terraform-plugin-framework":   "terraformpluginframework",
+		"github.com/hashicorp/aws-sdk-go-base/v2/endpoints": "endpoints",
+		"time": "time",
+	}
+
+	for importPath, want := range testCases {
+		if got := codegenPackageName(importPath); got != want {
+			t.Errorf("codegenPackageName(%q) = %q, want %q", importPath, got, want)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package codegen
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+// UpdateEnvVar is the environment variable that the autoflex command sets when running a package's TestAutoFlexCodeGen test.
+const UpdateEnvVar = "AUTOFLEX_CODEGEN_UPDATE"
+
+// Generate writes the source generated by `g` to the file `filename` when run by the autoflex command.
+// Otherwise, the test fails if the file is out of date.
+//
+// Generation runs in a test so that functions can be generated for model types that aren't exported.
+func Generate(t *testing.T, filename string, g *CodeGenerator) {
+	t.Helper()
+
+	got, err := g.Source()
+	if err != nil {
+		t.Fatalf("generating source: %s", err)
+	}
+
+	if os.Getenv(UpdateEnvVar) != "" {
+		if err := os.WriteFile(filename, got, 0o644); err != nil {
+			t.Fatalf("writing %s: %s", filename, err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("reading %s: %s", filename, err)
+	}
+
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s is out of date; run `go generate`", filename)
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/autoflex/codegen"
+)
+
+const testName = "TestAutoFlexCodeGen"
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [<go-test-flags>]\n\n")
+}
+
+func main() {
+	log.SetPrefix("generate/autoflex: ")
+	log.SetFlags(0)
+
+	if len(os.Args) > 1 && (os.Args[1] == "-h" || os.Args[1] == "-help") {
+		usage()
+		os.Exit(2)
+	}
+
+	args := append([]string{"test", "-count=1", "-run", "^" + testName + "$"}, os.Args[1:]...)
+	args = append(args, ".")
+
+	cmd := exec.Command("go", args...)
+	cmd.Env = append(os.Environ(), codegen.UpdateEnvVar+"=1")
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		log.Fatalf("running %s: %s", testName, err)
+	}
+}
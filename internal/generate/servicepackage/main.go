@@ -221,6 +221,8 @@ type ResourceDatum struct {
 	CustomImport                      bool
 	goImports                         []common.GoImport
 	HasIdentityFix                    bool
+	Sweeper                           bool
+	SweeperDependencies               []string
 	common.ResourceIdentity
 }
 
@@ -439,6 +441,16 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 			case "IdentityFix":
 				d.HasIdentityFix = true
 
+			case "Sweeper":
+				d.Sweeper = true
+				for _, typeName := range args.Positional {
+					if !validTypeName.MatchString(typeName) {
+						v.errs = append(v.errs, fmt.Errorf("invalid Sweeper dependency (%s): %s", typeName, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+						continue
+					}
+					d.SweeperDependencies = append(d.SweeperDependencies, typeName)
+				}
+
 			default:
 				if err := common.ParseResourceIdentity(annotationName, args, implementation, &d.ResourceIdentity, &d.goImports); err != nil {
 					v.errs = append(v.errs, fmt.Errorf("%s.%s: %w", v.packageName, v.functionName, err))
@@ -458,6 +470,10 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 		v.errs = append(v.errs, fmt.Errorf("%s.%s: %w", v.packageName, v.functionName, err))
 	}
 
+	if d.Sweeper && !slices.Contains(keys, "FrameworkListResource") && !slices.Contains(keys, "SDKListResource") {
+		v.errs = append(v.errs, fmt.Errorf(`"Sweeper" can only be specified on a list resource: %s`, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+	}
+
 	// Then build the resource maps, looking for duplicates.
 	for _, line := range funcDecl.Doc.List {
 		line := line.Text
@@ -678,7 +694,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					v.sdkListResources[typeName] = d
 				}
 
-			case "IdentityAttribute", "ArnIdentity", "ImportIDHandler", "MutableIdentity", "SingletonIdentity", "Region", "Tags", "WrappedImport", "V60SDKv2Fix", "IdentityFix", "CustomImport", "IdentityVersion", "CustomInherentRegionIdentity":
+			case "IdentityAttribute", "ArnIdentity", "ImportIDHandler", "MutableIdentity", "SingletonIdentity", "Region", "Tags", "WrappedImport", "V60SDKv2Fix", "IdentityFix", "CustomImport", "IdentityVersion", "CustomInherentRegionIdentity", "Sweeper":
 				// Handled above.
 			case "ArnFormat", "IdAttrFormat", "NoImport", "Testing":
 				// Ignored.
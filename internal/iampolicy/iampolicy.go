@@ -0,0 +1,665 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package iampolicy canonicalizes and compares AWS IAM policy documents.
+//
+// It is the single policy engine used by Terraform Plugin SDKv2 difference suppression and state functions,
+// by the Terraform Plugin Framework `IAMPolicy` type's semantic equality and by the `iam_policy_*` provider functions.
+package iampolicy
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+// Canonicalize returns the canonical form of a policy document.
+//
+// The canonical form is compact JSON with elements in the conventional order (`Version`, `Id`, `Statement`, and
+// `Sid`, `Effect`, `Principal`, `NotPrincipal`, `Action`, `NotAction`, `Resource`, `NotResource`, `Condition` in
+// each statement) and with `Statement` always a list.
+// The values of `Principal`, `NotPrincipal`, `Action`, `NotAction`, `Resource`, `NotResource` and conditions
+// are sorted and de-duplicated, and single element lists are replaced by the element.
+// Empty values are removed. Statement order, the case of all names and values, and unrecognized elements are preserved.
+//
+// An empty string is returned unchanged.
+func Canonicalize(policy string) (string, error) {
+	if strings.TrimSpace(policy) == "" {
+		return "", nil
+	}
+
+	doc, err := parse(policy)
+	if err != nil {
+		return "", err
+	}
+
+	return doc.canonical(false).String(), nil
+}
+
+// Equivalent returns whether two policy documents are equivalent.
+//
+// In addition to the differences removed by Canonicalize, Equivalent ignores
+//   - the order of statements,
+//   - the case of `Effect`, `Action` and `NotAction` values, and of condition keys,
+//   - the representation of condition values, e.g. `true` and `"true"`,
+//   - the difference between an AWS account ID principal, e.g. `"123456789012"`, and the account's root user ARN,
+//     e.g. `"arn:aws:iam::123456789012:root"`, in `Principal` or `NotPrincipal`,
+//   - the difference between the `"*"` and `{"AWS": "*"}` wildcard principals,
+//   - unrecognized elements.
+//
+// Empty strings and empty JSON objects are equivalent.
+func Equivalent(policy1, policy2 string) (bool, error) {
+	doc1, err1 := parse(policy1)
+	doc2, err2 := parse(policy2)
+
+	// Identical JSON documents are equivalent even if they aren't valid policies.
+	if (err1 != nil || err2 != nil) && tfjson.EqualStrings(policy1, policy2) {
+		return true, nil
+	}
+
+	if err1 != nil {
+		return false, fmt.Errorf("parsing policy 1: %w", err1)
+	}
+
+	if err2 != nil {
+		return false, fmt.Errorf("parsing policy 2: %w", err2)
+	}
+
+	return doc1.canonical(true).String() == doc2.canonical(true).String(), nil
+}
+
+// Merge merges policy documents into a single policy document in canonical form (see Canonicalize).
+//
+// The merged document has the first `Version` and `Id` that are set and the statements of all documents in order.
+// A statement with the same `Sid` as an earlier statement replaces it in place, and a statement without a `Sid`
+// whose canonical form is the same as an earlier statement's is dropped.
+func Merge(policies ...string) (string, error) {
+	merged := &document{}
+	sids := make(map[string]int)
+	seen := make(map[string]struct{})
+
+	for i, policy := range policies {
+		doc, err := parse(policy)
+		if err != nil {
+			return "", fmt.Errorf("parsing policy %d: %w", i+1, err)
+		}
+
+		merged.version = cmp.Or(merged.version, doc.version)
+		merged.id = cmp.Or(merged.id, doc.id)
+		for k, v := range doc.other {
+			if merged.other == nil {
+				merged.other = make(map[string]any)
+			}
+			if _, ok := merged.other[k]; !ok {
+				merged.other[k] = v
+			}
+		}
+
+		for _, s := range doc.statements {
+			if s.sid != "" {
+				if j, ok := sids[s.sid]; ok {
+					merged.statements[j] = s
+				} else {
+					sids[s.sid] = len(merged.statements)
+					merged.statements = append(merged.statements, s)
+				}
+				continue
+			}
+
+			key := s.canonical(false).String()
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			merged.statements = append(merged.statements, s)
+		}
+	}
+
+	return merged.canonical(false).String(), nil
+}
+
+// document is a parsed policy document.
+type document struct {
+	version, id string
+	statements  []*statement
+	other       map[string]any
+}
+
+// statement is a parsed policy statement.
+type statement struct {
+	sid, effect             string
+	principal, notPrincipal *principal
+	action, notAction       []string
+	resource, notResource   []string
+	// condition maps condition operators to condition keys to values.
+	condition map[string]map[string][]any
+	other     map[string]any
+}
+
+// principal is a parsed `Principal` or `NotPrincipal` element.
+type principal struct {
+	// scalar is set for a principal that isn't a map, e.g. `"*"`.
+	scalar string
+	// values maps principal types, e.g. `AWS` or `Service`, to values.
+	values map[string][]string
+}
+
+const (
+	elementAction       = "Action"
+	elementCondition    = "Condition"
+	elementEffect       = "Effect"
+	elementID           = "Id"
+	elementNotAction    = "NotAction"
+	elementNotPrincipal = "NotPrincipal"
+	elementNotResource  = "NotResource"
+	elementPrincipal    = "Principal"
+	elementResource     = "Resource"
+	elementSid          = "Sid"
+	elementStatement    = "Statement"
+	elementVersion      = "Version"
+
+	principalTypeAWS = "AWS"
+	wildcard         = "*"
+)
+
+func parse(policy string) (*document, error) {
+	policy = strings.TrimSpace(policy)
+	// Some APIs return a policy wrapped in a single element list.
+	if strings.HasPrefix(policy, "[") && strings.HasSuffix(policy, "]") {
+		policy = strings.TrimSpace(policy[1 : len(policy)-1])
+	}
+	if policy == "" {
+		return &document{}, nil
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(policy))
+	decoder.UseNumber()
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+	if decoder.More() {
+		return nil, errors.New("invalid JSON: unexpected data after policy document")
+	}
+
+	m, ok := v.(map[string]any)
+	if !ok {
+		return nil, errors.New("policy document is not a JSON object")
+	}
+
+	doc := &document{}
+	for k, v := range m {
+		var err error
+		switch k {
+		case elementVersion:
+			doc.version, err = parseString(v)
+		case elementID:
+			doc.id, err = parseString(v)
+		case elementStatement:
+			doc.statements, err = parseStatements(v)
+		default:
+			if doc.other == nil {
+				doc.other = make(map[string]any)
+			}
+			doc.other[k] = v
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+	}
+
+	return doc, nil
+}
+
+func parseStatements(v any) ([]*statement, error) {
+	var elems []any
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case map[string]any:
+		elems = []any{v}
+	case []any:
+		elems = v
+	default:
+		return nil, fmt.Errorf("unexpected type %T", v)
+	}
+
+	statements := make([]*statement, 0, len(elems))
+	for i, v := range elems {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("statement %d: unexpected type %T", i, v)
+		}
+
+		s, err := parseStatement(m)
+		if err != nil {
+			return nil, fmt.Errorf("statement %d: %w", i, err)
+		}
+
+		statements = append(statements, s)
+	}
+
+	return statements, nil
+}
+
+func parseStatement(m map[string]any) (*statement, error) {
+	s := &statement{}
+	for k, v := range m {
+		var err error
+		switch k {
+		case elementSid:
+			s.sid, err = parseString(v)
+		case elementEffect:
+			s.effect, err = parseString(v)
+		case elementPrincipal:
+			s.principal, err = parsePrincipal(v)
+		case elementNotPrincipal:
+			s.notPrincipal, err = parsePrincipal(v)
+		case elementAction:
+			s.action, err = parseStrings(v)
+		case elementNotAction:
+			s.notAction, err = parseStrings(v)
+		case elementResource:
+			s.resource, err = parseStrings(v)
+		case elementNotResource:
+			s.notResource, err = parseStrings(v)
+		case elementCondition:
+			s.condition, err = parseCondition(v)
+		default:
+			if s.other == nil {
+				s.other = make(map[string]any)
+			}
+			s.other[k] = v
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+	}
+
+	return s, nil
+}
+
+func parsePrincipal(v any) (*principal, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return &principal{scalar: v}, nil
+	case map[string]any:
+		p := &principal{values: make(map[string][]string)}
+		for k, v := range v {
+			values, err := parseStrings(v)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+			if len(values) > 0 {
+				p.values[k] = values
+			}
+		}
+		if len(p.values) == 0 {
+			return nil, nil
+		}
+
+		return p, nil
+	default:
+		return nil, fmt.Errorf("unexpected type %T", v)
+	}
+}
+
+func parseCondition(v any) (map[string]map[string][]any, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	m, ok := v.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type %T", v)
+	}
+
+	condition := make(map[string]map[string][]any)
+	for operator, v := range m {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%s: unexpected type %T", operator, v)
+		}
+
+		for key, v := range m {
+			values, err := parseScalars(v)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s: %w", operator, key, err)
+			}
+			if len(values) == 0 {
+				continue
+			}
+
+			if condition[operator] == nil {
+				condition[operator] = make(map[string][]any)
+			}
+			condition[operator][key] = values
+		}
+	}
+	if len(condition) == 0 {
+		return nil, nil
+	}
+
+	return condition, nil
+}
+
+func parseString(v any) (string, error) {
+	switch v := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	default:
+		return "", fmt.Errorf("unexpected type %T", v)
+	}
+}
+
+// parseStrings parses a value that is a string or a list of strings.
+// Numbers and booleans are converted to strings.
+func parseStrings(v any) ([]string, error) {
+	values, err := parseScalars(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.ApplyToAll(values, scalarString), nil
+}
+
+// parseScalars parses a value that is a scalar or a list of scalars.
+func parseScalars(v any) ([]any, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case string, json.Number, bool:
+		return []any{v}, nil
+	case []any:
+		for i, v := range v {
+			switch v.(type) {
+			case string, json.Number, bool:
+			default:
+				return nil, fmt.Errorf("element %d: unexpected type %T", i, v)
+			}
+		}
+
+		return v, nil
+	default:
+		return nil, fmt.Errorf("unexpected type %T", v)
+	}
+}
+
+func scalarString(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// canonical returns the canonical form of the document.
+// If fold is true, differences that are ignored by Equivalent are also removed.
+func (doc *document) canonical(fold bool) object {
+	var result object
+
+	if doc.version != "" {
+		result = result.add(elementVersion, doc.version)
+	}
+	if doc.id != "" {
+		result = result.add(elementID, doc.id)
+	}
+
+	if len(doc.statements) > 0 {
+		statements := make([]object, 0, len(doc.statements))
+		for _, s := range doc.statements {
+			statements = append(statements, s.canonical(fold))
+		}
+		if fold {
+			slices.SortFunc(statements, func(a, b object) int {
+				return strings.Compare(a.String(), b.String())
+			})
+		}
+
+		result = result.add(elementStatement, statements)
+	}
+
+	if !fold {
+		result = result.addOther(doc.other)
+	}
+
+	return result
+}
+
+func (s *statement) canonical(fold bool) object {
+	var result object
+
+	if s.sid != "" {
+		result = result.add(elementSid, s.sid)
+	}
+	if effect := s.effect; effect != "" {
+		if fold {
+			effect = strings.ToLower(effect)
+		}
+		result = result.add(elementEffect, effect)
+	}
+
+	for _, v := range []struct {
+		name  string
+		value *principal
+	}{
+		{elementPrincipal, s.principal},
+		{elementNotPrincipal, s.notPrincipal},
+	} {
+		if v.value != nil {
+			result = result.add(v.name, v.value.canonical(fold))
+		}
+	}
+
+	for _, v := range []struct {
+		name   string
+		values []string
+		fold   func(string) string
+	}{
+		{elementAction, s.action, strings.ToLower},
+		{elementNotAction, s.notAction, strings.ToLower},
+		{elementResource, s.resource, nil},
+		{elementNotResource, s.notResource, nil},
+	} {
+		values := v.values
+		if fold && v.fold != nil {
+			values = tfslices.ApplyToAll(values, v.fold)
+		}
+		if values := canonicalStrings(values); values != nil {
+			result = result.add(v.name, values)
+		}
+	}
+
+	if len(s.condition) > 0 {
+		result = result.add(elementCondition, canonicalCondition(s.condition, fold))
+	}
+
+	if !fold {
+		result = result.addOther(s.other)
+	}
+
+	return result
+}
+
+var accountIDRegexp = regexache.MustCompile(`^[0-9]{12}$`)
+
+func (p *principal) canonical(fold bool) any {
+	if !fold {
+		if p.values == nil {
+			return p.scalar
+		}
+
+		var result object
+		for _, k := range slices.Sorted(maps.Keys(p.values)) {
+			result = result.add(k, canonicalStrings(p.values[k]))
+		}
+
+		return result
+	}
+
+	// Fold to a map of principal types to sorted values, with "*" equivalent to {"AWS": "*"}
+	// and a scalar principal under an empty type.
+	values := p.values
+	if values == nil {
+		k := ""
+		if p.scalar == wildcard {
+			k = principalTypeAWS
+		}
+		values = map[string][]string{k: {p.scalar}}
+	}
+
+	var result object
+	for _, k := range slices.Sorted(maps.Keys(values)) {
+		result = result.add(k, sortedStrings(tfslices.ApplyToAll(values[k], foldAccountPrincipal)))
+	}
+
+	return result
+}
+
+// foldAccountPrincipal returns the account ID for an account root user ARN,
+// e.g. "123456789012" for "arn:aws:iam::123456789012:root".
+func foldAccountPrincipal(v string) string {
+	if a, err := arn.Parse(v); err == nil && a.Service == "iam" && a.Resource == "root" && accountIDRegexp.MatchString(a.AccountID) {
+		return a.AccountID
+	}
+
+	return v
+}
+
+func canonicalCondition(condition map[string]map[string][]any, fold bool) object {
+	var result object
+
+	for _, operator := range slices.Sorted(maps.Keys(condition)) {
+		keys := condition[operator]
+		if fold {
+			// Condition keys are case-insensitive.
+			folded := make(map[string][]any, len(keys))
+			for k, v := range keys {
+				k = strings.ToLower(k)
+				folded[k] = append(folded[k], v...)
+			}
+			keys = folded
+		}
+
+		var values object
+		for _, k := range slices.Sorted(maps.Keys(keys)) {
+			if fold {
+				values = values.add(k, sortedStrings(tfslices.ApplyToAll(keys[k], scalarString)))
+			} else {
+				values = values.add(k, canonicalScalars(keys[k]))
+			}
+		}
+
+		result = result.add(operator, values)
+	}
+
+	return result
+}
+
+// canonicalStrings returns sorted, de-duplicated values, the only value if there is one, or nil if there are none.
+func canonicalStrings(values []string) any {
+	switch values = sortedStrings(values); len(values) {
+	case 0:
+		return nil
+	case 1:
+		return values[0]
+	default:
+		return values
+	}
+}
+
+// canonicalScalars returns values sorted by their string representation and de-duplicated, or the only value if there is one.
+func canonicalScalars(values []any) any {
+	values = slices.Clone(values)
+	slices.SortStableFunc(values, func(a, b any) int {
+		return strings.Compare(scalarString(a), scalarString(b))
+	})
+	values = slices.CompactFunc(values, func(a, b any) bool {
+		return a == b
+	})
+
+	if len(values) == 1 {
+		return values[0]
+	}
+
+	return values
+}
+
+// sortedStrings returns sorted, de-duplicated values.
+func sortedStrings(values []string) []string {
+	values = slices.Clone(values)
+	slices.Sort(values)
+
+	return slices.Compact(values)
+}
+
+// object is a JSON object whose members are marshaled in order.
+type object []member
+
+type member struct {
+	name  string
+	value any
+}
+
+func (o object) add(name string, value any) object {
+	return append(o, member{name: name, value: value})
+}
+
+// addOther adds unrecognized elements in name order.
+func (o object) addOther(other map[string]any) object {
+	for _, k := range slices.Sorted(maps.Keys(other)) {
+		o = o.add(k, other[k])
+	}
+
+	return o
+}
+
+func (o object) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteByte('{')
+	for i, m := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		name, err := json.Marshal(m.name)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+
+		value, err := json.Marshal(m.value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+func (o object) String() string {
+	b, _ := json.Marshal(o)
+
+	return string(b)
+}
@@ -0,0 +1,548 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package iampolicy_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
+)
+
+func TestCanonicalize(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy    string
+		expected  string
+		wantError bool
+	}{
+		"empty": {
+			policy:   " ",
+			expected: "",
+		},
+		"empty JSON": {
+			policy:   "{}",
+			expected: "{}",
+		},
+		"element order": {
+			policy: `{
+  "Statement": [{
+    "Resource": "*",
+    "Action": "s3:GetObject",
+    "Effect": "Allow",
+    "Sid": "Example"
+  }],
+  "Id": "Policy1",
+  "Version": "2012-10-17"
+}`,
+			expected: `{"Version":"2012-10-17","Id":"Policy1","Statement":[{"Sid":"Example","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		"single statement": {
+			policy:   `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}}`,
+			expected: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		"single element lists": {
+			policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":["lambda.amazonaws.com"]},"Action":["s3:GetObject"],"Resource":["*"],"Condition":{"Bool":{"aws:SecureTransport":[false]}}}]}`,
+			expected: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"lambda.amazonaws.com"},"Action":"s3:GetObject","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":false}}}]}`,
+		},
+		"sorted and de-duplicated values": {
+			policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","NotPrincipal":{"Service":"b.amazonaws.com","AWS":["arn:aws:iam::123456789012:role/b","arn:aws:iam::123456789012:role/a"]},"NotAction":["s3:PutObject","s3:GetObject","s3:PutObject"],"NotResource":["arn:aws:s3:::b","arn:aws:s3:::a"],"Condition":{"StringLike":{"s3:prefix":["b/","a/"]},"IpAddress":{"aws:SourceIp":["192.0.2.0/24","198.51.100.0/24"]}}}]}`,
+			expected: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","NotPrincipal":{"AWS":["arn:aws:iam::123456789012:role/a","arn:aws:iam::123456789012:role/b"],"Service":"b.amazonaws.com"},"NotAction":["s3:GetObject","s3:PutObject"],"NotResource":["arn:aws:s3:::a","arn:aws:s3:::b"],"Condition":{"IpAddress":{"aws:SourceIp":["192.0.2.0/24","198.51.100.0/24"]},"StringLike":{"s3:prefix":["a/","b/"]}}}]}`,
+		},
+		"empty values": {
+			policy:   `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Principal":{"AWS":[]},"Action":"s3:GetObject","Resource":"*","Condition":{}}]}`,
+			expected: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		"case and statement order preserved": {
+			policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"S3:DeleteObject","Resource":"*"},{"Effect":"Allow","Principal":"*","Action":"s3:getObject","Resource":"*","Condition":{"StringEquals":{"AWS:SourceAccount":"123456789012"}}}]}`,
+			expected: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"S3:DeleteObject","Resource":"*"},{"Effect":"Allow","Principal":"*","Action":"s3:getObject","Resource":"*","Condition":{"StringEquals":{"AWS:SourceAccount":"123456789012"}}}]}`,
+		},
+		"unrecognized elements preserved": {
+			policy:   `{"Version":"2012-10-17","Extra":{"b":1,"a":2},"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Other":true}]}`,
+			expected: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Other":true}],"Extra":{"a":2,"b":1}}`,
+		},
+		"list wrapped": {
+			policy:   `[{"Version":"2012-10-17","Statement":[]}]`,
+			expected: `{"Version":"2012-10-17"}`,
+		},
+		"invalid JSON": {
+			policy:    `{"Version":`,
+			wantError: true,
+		},
+		"invalid condition": {
+			policy:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:ListBucket","Resource":"*","Condition":{"StringLike":["demo-prefix/"]}}]}`,
+			wantError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := iampolicy.Canonicalize(testCase.policy)
+
+			if got, want := err != nil, testCase.wantError; got != want {
+				t.Fatalf("Canonicalize(%q) err %t, want %t", testCase.policy, got, want)
+			}
+			if err != nil {
+				return
+			}
+
+			if got != testCase.expected {
+				t.Errorf("Canonicalize(%q) = %q, want %q", testCase.policy, got, testCase.expected)
+			}
+
+			// Canonicalization is idempotent and preserves equivalence.
+			again, err := iampolicy.Canonicalize(got)
+			if err != nil {
+				t.Fatalf("Canonicalize(%q): %s", got, err)
+			}
+			if again != got {
+				t.Errorf("Canonicalize(%q) = %q, want %q", got, again, got)
+			}
+
+			equivalent, err := iampolicy.Equivalent(testCase.policy, got)
+			if err != nil {
+				t.Fatalf("Equivalent(%q, %q): %s", testCase.policy, got, err)
+			}
+			if !equivalent {
+				t.Errorf("Equivalent(%q, %q) = false, want true", testCase.policy, got)
+			}
+		})
+	}
+}
+
+func TestEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy1, policy2 string
+		expected         bool
+		wantError        bool
+	}{
+		"empty": {
+			policy1:  "",
+			policy2:  " {} ",
+			expected: true,
+		},
+		"empty and non-empty": {
+			policy1: "",
+			policy2: `{"Version":"2012-10-17"}`,
+		},
+		"principal ordering": {
+			policy1:  `{"Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:role/a","arn:aws:iam::123456789012:role/b"],"Service":"lambda.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			policy2:  `{"Statement":[{"Effect":"Allow","Principal":{"Service":["lambda.amazonaws.com"],"AWS":["arn:aws:iam::123456789012:role/b","arn:aws:iam::123456789012:role/a"]},"Action":"sts:AssumeRole"}]}`,
+			expected: true,
+		},
+		"principal changed": {
+			policy1: `{"Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:role/a","arn:aws:iam::123456789012:role/b"]},"Action":"sts:AssumeRole"}]}`,
+			policy2: `{"Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:role/a"]},"Action":"sts:AssumeRole"}]}`,
+		},
+		"account principal": {
+			policy1:  `{"Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"sts:AssumeRole"}]}`,
+			policy2:  `{"Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws-us-gov:iam::123456789012:root"]},"Action":"sts:AssumeRole"}]}`,
+			expected: true,
+		},
+		"wildcard principal": {
+			policy1:  `{"Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"*"}]}`,
+			policy2:  `{"Statement":[{"Effect":"Allow","Principal":{"AWS":["*"]},"Action":"s3:GetObject","Resource":"*"}]}`,
+			expected: true,
+		},
+		"wildcard principal and service": {
+			policy1: `{"Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"*"}]}`,
+			policy2: `{"Statement":[{"Effect":"Allow","Principal":{"Service":"*"},"Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		"not principal": {
+			policy1:  `{"Statement":[{"Effect":"Deny","NotPrincipal":{"AWS":["arn:aws:iam::123456789012:root","arn:aws:iam::123456789012:role/a"]},"Action":"s3:*","Resource":"*"}]}`,
+			policy2:  `{"Statement":[{"Effect":"Deny","NotPrincipal":{"AWS":["arn:aws:iam::123456789012:role/a","123456789012"]},"Action":"s3:*","Resource":"*"}]}`,
+			expected: true,
+		},
+		"principal and not principal": {
+			policy1: `{"Statement":[{"Effect":"Deny","Principal":{"AWS":"arn:aws:iam::123456789012:role/a"},"Action":"s3:*","Resource":"*"}]}`,
+			policy2: `{"Statement":[{"Effect":"Deny","NotPrincipal":{"AWS":"arn:aws:iam::123456789012:role/a"},"Action":"s3:*","Resource":"*"}]}`,
+		},
+		"action case": {
+			policy1:  `{"Statement":[{"Effect":"Allow","Action":["s3:GetObject","S3:PutObject"],"Resource":"*"}]}`,
+			policy2:  `{"Statement":[{"Effect":"allow","Action":["s3:putobject","s3:getobject"],"Resource":"*"}]}`,
+			expected: true,
+		},
+		"resource case": {
+			policy1: `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::Example/*"}]}`,
+			policy2: `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*"}]}`,
+		},
+		"condition key case": {
+			policy1:  `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEquals":{"aws:SourceAccount":"123456789012"},"Bool":{"aws:SecureTransport":"true"}}}]}`,
+			policy2:  `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"Bool":{"AWS:securetransport":[true]},"StringEquals":{"aws:sourceaccount":["123456789012"]}}}]}`,
+			expected: true,
+		},
+		"condition value case": {
+			policy1: `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEquals":{"aws:PrincipalTag/team":"Blue"}}}]}`,
+			policy2: `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEquals":{"aws:PrincipalTag/team":"blue"}}}]}`,
+		},
+		"condition operator changed": {
+			policy1: `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEquals":{"aws:SourceAccount":"123456789012"}}}]}`,
+			policy2: `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringLike":{"aws:SourceAccount":"123456789012"}}}]}`,
+		},
+		"statement order": {
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Sid":"B","Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Sid":"B","Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"},{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			expected: true,
+		},
+		"sid changed": {
+			policy1: `{"Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			policy2: `{"Statement":[{"Sid":"B","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		"identical invalid policies": {
+			policy1:  `{"Statement":[{"Effect":"Allow","Resource":{"a":"b"}}]}`,
+			policy2:  `{"Statement": [{"Resource": {"a": "b"}, "Effect": "Allow"}]}`,
+			expected: true,
+		},
+		"invalid policy": {
+			policy1:   `{"Statement":[{"Effect":"Allow","Resource":{"a":"b"}}]}`,
+			policy2:   `{"Statement":[{"Effect":"Allow","Resource":"*"}]}`,
+			wantError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, v := range [][2]string{{testCase.policy1, testCase.policy2}, {testCase.policy2, testCase.policy1}} {
+				got, err := iampolicy.Equivalent(v[0], v[1])
+
+				if got, want := err != nil, testCase.wantError; got != want {
+					t.Fatalf("Equivalent(%q, %q) err %t, want %t", v[0], v[1], got, want)
+				}
+
+				if got != testCase.expected {
+					t.Errorf("Equivalent(%q, %q) = %t, want %t", v[0], v[1], got, testCase.expected)
+				}
+			}
+		})
+	}
+}
+
+// TestEquivalent_awsPolicyEquivalence runs the test cases of github.com/hashicorp/awspolicyequivalence,
+// which Equivalent replaces.
+func TestEquivalent_awsPolicyEquivalence(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy1, policy2 string
+		expected         bool
+		wantError        bool
+	}{
+		"Invalid policy JSON": {
+			policy1:   `{"Version":"2012-10-17","Statement":[{]}`,
+			policy2:   `{"Version":"2012-10-17","Statement":[{]}`,
+			wantError: true,
+		},
+		"Identical policy text": {
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Principal":{"Service":"spotfleet.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Principal":{"Service":"spotfleet.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			expected: true,
+		},
+		"Action block as single item array versus string": {
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Principal":{"Service":"spotfleet.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Sid":"","Action":["sts:AssumeRole"],"Effect":"Allow","Principal":{"Service":"spotfleet.amazonaws.com"}}]}`,
+			expected: true,
+		},
+		"Action block as single item array versus string, different action": {
+			policy1: `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Principal":{"Service":"spotfleet.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Sid":"","Action":["sts:GetSessionToken"],"Effect":"Allow","Principal":{"Service":"spotfleet.amazonaws.com"}}]}`,
+		},
+		"NotAction block and ActionBlock, mixed string versus array": {
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Principal":{"Service":"spotfleet.amazonaws.com"},"Action":"sts:AssumeRole","NotAction":["sts:GetSessionToken"]}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Sid":"","Action":"sts:AssumeRole","NotAction":"sts:GetSessionToken","Effect":"Allow","Principal":{"Service":"spotfleet.amazonaws.com"}}]}`,
+			expected: true,
+		},
+		"NotAction block on one side": {
+			policy1: `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Principal":{"Service":"spotfleet.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Sid":"","Action":"sts:AssumeRole","NotAction":"sts:GetSessionToken","Effect":"Allow","Principal":{"Service":"spotfleet.amazonaws.com"}}]}`,
+		},
+		"Principal in single item array versus string": {
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Principal":{"Service":"spotfleet.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Sid":"","Action":"sts:AssumeRole","Effect":"Allow","Principal":{"Service":["spotfleet.amazonaws.com"]}}]}`,
+			expected: true,
+		},
+		"Different principal in single item array versus string": {
+			policy1: `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Sid":"","Action":"sts:AssumeRole","Effect":"Allow","Principal":{"Service":["spotfleet.amazonaws.com"]}}]}`,
+		},
+		"String principal": {
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Principal":"*","Action":"sts:AssumeRole"}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Sid":"","Action":"sts:AssumeRole","Effect":"Allow","Principal":"*"}]}`,
+			expected: true,
+		},
+		"String NotPrincipal": {
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","NotPrincipal":"*","Action":"sts:AssumeRole"}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Sid":"","Action":"sts:AssumeRole","Effect":"Allow","NotPrincipal":"*"}]}`,
+			expected: true,
+		},
+		"Different NotPrincipal in single item array versus string": {
+			policy1: `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","NotPrincipal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Sid":"","Action":"sts:AssumeRole","Effect":"Allow","NotPrincipal":{"Service":["spotfleet.amazonaws.com"]}}]}`,
+		},
+		"Different Effect": {
+			policy1: `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Principal":{"Service":"spotfleet.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			policy2: `{"Version":"2012-06-17","Statement":[{"Sid":"","Effect":"Allow","Principal":{"Service":"spotfleet.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+		},
+		"Different Version": {
+			policy1: `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Deny","Principal":{"Service":"spotfleet.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Principal":{"Service":"spotfleet.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+		},
+		"Same Condition": {
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Sid":"statement1","Effect":"Allow","Action":["s3:PutObject"],"Resource":["arn:aws:s3:::examplebucket/*"],"Condition":{"StringEquals":{"s3:x-amz-acl":["public-read"]}}}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Sid":"statement1","Effect":"Allow","Action":["s3:PutObject"],"Resource":["arn:aws:s3:::examplebucket/*"],"Condition":{"StringEquals":{"s3:x-amz-acl":["public-read"]}}}]}`,
+			expected: true,
+		},
+		"Different Condition": {
+			policy1: `{"Version":"2012-10-17","Statement":[{"Sid":"statement1","Effect":"Allow","Action":["s3:PutObject"],"Resource":["arn:aws:s3:::examplebucket/*"],"Condition":{"StringNotEquals":{"s3:x-amz-acl":["public-read"]}}}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Sid":"statement1","Effect":"Allow","Action":["s3:PutObject"],"Resource":["arn:aws:s3:::examplebucket/*"],"Condition":{"StringEquals":{"s3:x-amz-acl":["public-read"]}}}]}`,
+		},
+		"Condition in single string instead of array": {
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Sid":"statement1","Effect":"Allow","Action":["s3:PutObject"],"Resource":["arn:aws:s3:::examplebucket/*"],"Condition":{"StringEquals":{"s3:x-amz-acl":"public-read"}}}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Sid":"statement1","Effect":"Allow","Action":["s3:PutObject"],"Resource":["arn:aws:s3:::examplebucket/*"],"Condition":{"StringEquals":{"s3:x-amz-acl":["public-read"]}}}]}`,
+			expected: true,
+		},
+		"Multiple Condition Blocks in one policy": {
+			policy1: `{"Version":"2012-10-17","Statement":[{"Sid":"statement1","Effect":"Allow","Action":["s3:PutObject"],"Resource":["arn:aws:s3:::examplebucket/*"],"Condition":{"StringEquals":{"s3:x-amz-acl":"public-read"}}}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Sid":"statement1","Effect":"Allow","Action":["s3:PutObject"],"Resource":["arn:aws:s3:::examplebucket/*"],"Condition":{"DateGreaterThan":{"aws:CurrentTime":"2013-08-16T12:00:00Z"},"DateLessThan":{"aws:CurrentTime":"2013-08-16T15:00:00Z"},"IpAddress":{"aws:SourceIp":["192.0.2.0/24","203.0.113.0/24"]}}}]}`,
+		},
+		"Multiple Condition Blocks, same in both policies": {
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Sid":"statement1","Effect":"Allow","Action":["s3:PutObject"],"Resource":["arn:aws:s3:::examplebucket/*"],"Condition":{"DateGreaterThan":{"aws:CurrentTime":"2013-08-16T12:00:00Z"},"DateLessThan":{"aws:CurrentTime":"2013-08-16T15:00:00Z"},"IpAddress":{"aws:SourceIp":["192.0.2.0/24","203.0.113.0/24"]}}}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Sid":"statement1","Effect":"Allow","Action":["s3:PutObject"],"Resource":["arn:aws:s3:::examplebucket/*"],"Condition":{"DateGreaterThan":{"aws:CurrentTime":"2013-08-16T12:00:00Z"},"DateLessThan":{"aws:CurrentTime":"2013-08-16T15:00:00Z"},"IpAddress":{"aws:SourceIp":["192.0.2.0/24","203.0.113.0/24"]}}}]}`,
+			expected: true,
+		},
+		"Multiple Statements, Equivalent": {
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:ListAllMyBuckets","s3:GetBucketLocation"],"Resource":"arn:aws:s3:::*"},{"Effect":"Allow","Action":"s3:ListBucket","Resource":"arn:aws:s3:::BUCKET-NAME","Condition":{"StringLike":{"s3:prefix":["","home/","home/${aws:username}/"]}}},{"Effect":"Allow","Action":"s3:*","Resource":["arn:aws:s3:::BUCKET-NAME/home/${aws:username}","arn:aws:s3:::BUCKET-NAME/home/${aws:username}/*"]}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:ListAllMyBuckets","s3:GetBucketLocation"],"Resource":"arn:aws:s3:::*"},{"Effect":"Allow","Action":"s3:ListBucket","Resource":"arn:aws:s3:::BUCKET-NAME","Condition":{"StringLike":{"s3:prefix":["","home/","home/${aws:username}/"]}}},{"Effect":"Allow","Action":"s3:*","Resource":["arn:aws:s3:::BUCKET-NAME/home/${aws:username}","arn:aws:s3:::BUCKET-NAME/home/${aws:username}/*"]}]}`,
+			expected: true,
+		},
+		"Multiple Statements, missing one from policy 2": {
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:ListAllMyBuckets","s3:GetBucketLocation"],"Resource":"arn:aws:s3:::*"},{"Effect":"Allow","Action":"s3:*","Resource":["arn:aws:s3:::BUCKET-NAME/home/${aws:username}","arn:aws:s3:::BUCKET-NAME/home/${aws:username}/*"]},{"Effect":"Allow","Action":"s3:ListBucket","Resource":"arn:aws:s3:::BUCKET-NAME","Condition":{"StringLike":{"s3:prefix":["","home/","home/${aws:username}/"]}}}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:ListAllMyBuckets","s3:GetBucketLocation"],"Resource":"arn:aws:s3:::*"},{"Effect":"Allow","Action":"s3:*","Resource":["arn:aws:s3:::BUCKET-NAME/home/${aws:username}","arn:aws:s3:::BUCKET-NAME/home/${aws:username}/*"]}]}`,
+		},
+		"Casing of Effect": {
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Principal":{"Service":"spotfleet.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Sid":"","Action":["sts:AssumeRole"],"Effect":"allow","Principal":{"Service":"spotfleet.amazonaws.com"}}]}`,
+			expected: true,
+		},
+		"Single Statement vs []Statement": {
+			policy1:  `{"Version":"2012-10-17","Statement":{"Sid":"","Effect":"Allow","Principal":{"Service":"spotfleet.amazonaws.com"},"Action":"sts:AssumeRole"}}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Sid":"","Action":["sts:AssumeRole"],"Effect":"allow","Principal":{"Service":"spotfleet.amazonaws.com"}}]}`,
+			expected: true,
+		},
+		"Empty Principal set": {
+			policy1:  `{"Version":"2012-10-17","Statement":{"Sid":"","Effect":"Allow","Principal":{},"Action":"sts:AssumeRole"}}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Sid":"","Action":["sts:AssumeRole"],"Effect":"allow","Principal":{"Service":[]}}]}`,
+			expected: true,
+		},
+		"Empty Principals sets of different types have the same effect": {
+			policy1:  `{"Version":"2012-10-17","Statement":{"Sid":"","Effect":"Allow","Principal":{"Service":[]},"Action":"sts:AssumeRole"}}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Sid":"","Action":["sts:AssumeRole"],"Effect":"allow","Principal":{"AWS":[]}}]}`,
+			expected: true,
+		},
+		"Empty Principal and missing Principal have the same effect": {
+			policy1:  `{"Version":"2012-10-17","Statement":{"Sid":"","Effect":"Allow","Principal":{},"Action":"sts:AssumeRole"}}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Sid":"","Action":["sts:AssumeRole"],"Effect":"allow"}]}`,
+			expected: true,
+		},
+		"Principal with empty sets and missing Principal have the same effect": {
+			policy1:  `{"Version":"2012-10-17","Statement":{"Sid":"","Effect":"Allow","Principal":{"Service":[],"AWS":[]},"Action":"sts:AssumeRole"}}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Sid":"","Action":["sts:AssumeRole"],"Effect":"allow"}]}`,
+			expected: true,
+		},
+		"Principal with string root IAM user matches account ID": {
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Action":"*","Resource":"*","Principal":"123456789012"}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Action":"*","Resource":"*","Principal":"arn:PARTITION:iam::123456789012:root"}]}`,
+			expected: true,
+		},
+		"Principal with map string root IAM user matches account ID": {
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Action":"*","Resource":"*","Principal":{"AWS":"123456789012"}}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Action":"*","Resource":"*","Principal":{"AWS":"arn:PARTITION:iam::123456789012:root"}}]}`,
+			expected: true,
+		},
+		"Principal with map array single root IAM user matches account ID": {
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Action":"*","Resource":"*","Principal":{"AWS":["123456789012"]}}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Action":"*","Resource":"*","Principal":{"AWS":["arn:PARTITION:iam::123456789012:root"]}}]}`,
+			expected: true,
+		},
+		"Principal with map array multiple root IAM user matches account ID": {
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Action":"*","Resource":"*","Principal":{"AWS":["123456789012","arn:PARTITION:iam::999999999999:root"]}}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Action":"*","Resource":"*","Principal":{"AWS":["arn:PARTITION:iam::123456789012:root","arn:PARTITION:iam::999999999999:root"]}}]}`,
+			expected: true,
+		},
+		"Missing Statement": {
+			policy1:  `{"Version":"2012-10-17"}`,
+			policy2:  `{"Version":"2012-10-17"}`,
+			expected: true,
+		},
+		"Incorrect Statement type": {
+			policy1:  `{"Version":"2012-10-17","Statement":42}`,
+			policy2:  `{"Version":"2012-10-17","Statement":42}`,
+			expected: true,
+		},
+		"Incorrect single Resource type": {
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Sid":"statement1","Effect":"Allow","Action":["s3:PutObject"],"Resource":{}}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Sid":"statement1","Effect":"Allow","Action":["s3:PutObject"],"Resource":{}}]}`,
+			expected: true,
+		},
+		"Incorrect multiple Resource type": {
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Sid":"statement1","Effect":"Allow","Action":["s3:PutObject"],"Resource":[[42]]}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Sid":"statement1","Effect":"Allow","Action":["s3:PutObject"],"Resource":[[42]]}]}`,
+			expected: true,
+		},
+		"Principal order not important": {
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Action":"*","Resource":"*","Principal":{"AWS":["arn:aws:iam::123456789012:role/franz-ferdinand","arn:aws:iam::123456789012:role/morgan-page","arn:aws:iam::123456789012:role/built-by-titan","arn:aws:iam::123456789012:role/kristina_sky","arn:aws:iam::123456789012:role/maria_becerra","arn:aws:iam::123456789012:role/jodie_knight"]}}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Action":"*","Resource":"*","Principal":{"AWS":["arn:aws:iam::123456789012:role/maria_becerra","arn:aws:iam::123456789012:role/morgan-page","arn:aws:iam::123456789012:role/kristina_sky","arn:aws:iam::123456789012:role/jodie_knight","arn:aws:iam::123456789012:role/franz-ferdinand","arn:aws:iam::123456789012:role/built-by-titan"]}}]}`,
+			expected: true,
+		},
+		"Differences matter": {
+			policy1: `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Action":"*","Resource":"*","Principal":{"AWS":["arn:aws:iam::123456789012:role/maria_becerra","arn:aws:iam::123456789012:role/morgan-page","arn:aws:iam::123456789012:role/kristina_sky","arn:aws:iam::123456789012:role/jodie_knight","arn:aws:iam::123456789012:role/franz-ferdinand","arn:aws:iam::123456789012:role/built-by-titan"]}}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Action":"*","Resource":"*","Principal":{"AWS":["arn:aws:iam::123456789012:role/maria_becerra","arn:aws:iam::123456789012:role/morgan-page","arn:aws:iam::123456789012:role/kristina_sky","arn:aws:iam::123456789012:role/jodie_knight","arn:aws:iam::123456789012:role/franz-ferdinand","arn:aws:iam::123456789012:role/idina-menzel"]}}]}`,
+		},
+		"Boolean condition with and without quotes on single value": {
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Sid":"statement1","Effect":"Allow","Action":["s3:PutObject"],"Resource":["arn:aws:s3:::examplebucket/*"],"Condition":{"Bool":{"aws:MultiFactorAuthPresent":true}}}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Sid":"statement1","Effect":"Allow","Action":["s3:PutObject"],"Resource":["arn:aws:s3:::examplebucket/*"],"Condition":{"Bool":{"aws:MultiFactorAuthPresent":"true"}}}]}`,
+			expected: true,
+		},
+		"Numeric condition with and without quotes on single value": {
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Sid":"statement1","Effect":"Allow","Action":["s3:PutObject"],"Resource":["arn:aws:s3:::examplebucket/*"],"Condition":{"NumericLessThanEquals":{"aws:MultiFactorAuthAge":100}}}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Sid":"statement1","Effect":"Allow","Action":["s3:PutObject"],"Resource":["arn:aws:s3:::examplebucket/*"],"Condition":{"NumericLessThanEquals":{"aws:MultiFactorAuthAge":"100"}}}]}`,
+			expected: true,
+		},
+		"Numeric condition with and without quotes on array of values": {
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Sid":"statement1","Effect":"Allow","Action":["s3:PutObject"],"Resource":["arn:aws:s3:::examplebucket/*"],"Condition":{"NumericEquals":{"aws:MultiFactorAuthAge":[100.01,200.2]}}}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Sid":"statement1","Effect":"Allow","Action":["s3:PutObject"],"Resource":["arn:aws:s3:::examplebucket/*"],"Condition":{"NumericEquals":{"aws:MultiFactorAuthAge":["100.01","200.2"]}}}]}`,
+			expected: true,
+		},
+		"Condition containing empty array": {
+			policy1:  `{"Id":"sqspolicy","Statement":[{"Action":"sqs:SendMessage","Condition":{"ArnEquals":{"aws:SourceArn":[]}},"Effect":"Allow","Principal":"*","Resource":"arn:aws:sqs:***:redacted:redacted","Sid":"AllowSNSSubscriptions"}],"Version":"2012-10-17"}`,
+			policy2:  `{"Version":"2012-10-17","Id":"sqspolicy","Statement":[{"Sid":"AllowSNSSubscriptions","Effect":"Allow","Principal":"*","Action":"sqs:SendMessage","Resource":"arn:aws:sqs:***:redacted:redacted","Condition":{"ArnEquals":{"aws:SourceArn":[]}}}]}`,
+			expected: true,
+		},
+		"Different empty lists": {
+			policy1:  `[]`,
+			policy2:  `[{}]`,
+			expected: true,
+		},
+		"One-length lists": {
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Sid":"statement1","Effect":"Allow","Action":["s3:PutObject"],"Resource":[42,86]}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Sid":"statement1","Effect":"Allow","Action":"s3:PutObject","Resource":[86,42]}]}`,
+			expected: true,
+		},
+		"Equivalent assume-role policies 1": {
+			policy1:  `{"Statement":[{"Action":"sts:AssumeRole","Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"}}],"Version":"2012-10-17"}`,
+			policy2:  `{"Statement":[{"Action":["sts:AssumeRole"],"Effect":"Allow","Principal":{"Service":["ec2.amazonaws.com"]}}],"Version":"2012-10-17"}`,
+			expected: true,
+		},
+		"Equivalent assume-role policies 2": {
+			policy1:  `{"Statement":[{"Action":"sts:AssumeRole","Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"}}],"Version":"2012-10-17"}`,
+			policy2:  `{"Statement":[{"Action":"sts:AssumeRole","Effect":"Allow","Principal":{"Service":["ec2.amazonaws.com"]}}],"Version":"2012-10-17"}`,
+			expected: true,
+		},
+		"Not equivalent assume-role policies": {
+			policy1: `{"Statement":[{"Action":"sts:AssumeRole","Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"}}],"Version":"2012-10-17"}`,
+			policy2: `{"Statement":[{"Action":"sts:AssumeRole","Effect":"Allow","Principal":{"Service":["rds.amazonaws.com"]}}],"Version":"2012-10-17"}`,
+		},
+		"Equivalence of emptiness": {
+			policy1:  ``,
+			policy2:  `{}`,
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := iampolicy.Equivalent(testCase.policy1, testCase.policy2)
+
+			if got, want := err != nil, testCase.wantError; got != want {
+				t.Fatalf("Equivalent(%q, %q) err %t, want %t", testCase.policy1, testCase.policy2, got, want)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("Equivalent(%q, %q) = %t, want %t", testCase.policy1, testCase.policy2, got, testCase.expected)
+			}
+			if err != nil {
+				return
+			}
+
+			// Policies stored in state are canonicalized, which mustn't change the result.
+			// Policies that can't be canonicalized are stored unchanged.
+			canonical1, err := iampolicy.Canonicalize(testCase.policy1)
+			if err != nil {
+				return
+			}
+			canonical2, err := iampolicy.Canonicalize(testCase.policy2)
+			if err != nil {
+				return
+			}
+
+			got, err = iampolicy.Equivalent(canonical1, canonical2)
+			if err != nil {
+				t.Fatalf("Equivalent(%q, %q): %s", canonical1, canonical2, err)
+			}
+			if got != testCase.expected {
+				t.Errorf("Equivalent(%q, %q) = %t, want %t", canonical1, canonical2, got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestMerge(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policies  []string
+		expected  string
+		wantError bool
+	}{
+		"no policies": {
+			expected: "{}",
+		},
+		"empty policies": {
+			policies: []string{"", "{}"},
+			expected: "{}",
+		},
+		"sid replaced and duplicate dropped": {
+			policies: []string{
+				`{"Version":"2012-10-17","Statement":[{"Sid":"S3","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Allow","Action":"sqs:SendMessage","Resource":"*"}]}`,
+				`{"Statement":[{"Sid":"S3","Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Resource":"*"},{"Effect":"Allow","Action":["sqs:SendMessage"],"Resource":["*"]},{"Effect":"Allow","Action":"sns:Publish","Resource":"*"}]}`,
+			},
+			expected: `{"Version":"2012-10-17","Statement":[{"Sid":"S3","Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":"*"},{"Effect":"Allow","Action":"sqs:SendMessage","Resource":"*"},{"Effect":"Allow","Action":"sns:Publish","Resource":"*"}]}`,
+		},
+		"first version and id": {
+			policies: []string{
+				`{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+				`{"Version":"2012-10-17","Id":"Policy1"}`,
+				`{"Version":"2008-10-17","Id":"Policy2"}`,
+			},
+			expected: `{"Version":"2012-10-17","Id":"Policy1","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		"invalid JSON": {
+			policies:  []string{"{}", `{"Statement":[`},
+			wantError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := iampolicy.Merge(testCase.policies...)
+
+			if got, want := err != nil, testCase.wantError; got != want {
+				t.Fatalf("Merge(%q) err %t, want %t", testCase.policies, got, want)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("Merge(%q) = %q, want %q", testCase.policies, got, testCase.expected)
+			}
+		})
+	}
+}
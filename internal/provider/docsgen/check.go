@@ -0,0 +1,311 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package docsgen
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ProblemType is the type of a disagreement between documentation and schema.
+type ProblemType string
+
+const (
+	// ProblemTypeDocMissing indicates that there is no documentation.
+	ProblemTypeDocMissing ProblemType = "doc_missing"
+	// ProblemTypeSectionMissing indicates that a required section, e.g. "Argument Reference", is missing.
+	ProblemTypeSectionMissing ProblemType = "section_missing"
+	// ProblemTypeArgumentUndocumented indicates that an argument or block isn't documented in the "Argument Reference" section.
+	ProblemTypeArgumentUndocumented ProblemType = "argument_undocumented"
+	// ProblemTypeAttributeUndocumented indicates that a computed-only attribute isn't documented.
+	ProblemTypeAttributeUndocumented ProblemType = "attribute_undocumented"
+	// ProblemTypeNameUnknown indicates that a documented argument or attribute isn't in the schema.
+	ProblemTypeNameUnknown ProblemType = "name_unknown"
+	// ProblemTypeRequiredMismatch indicates that a top-level argument is documented as required but is optional, or vice versa.
+	ProblemTypeRequiredMismatch ProblemType = "required_mismatch"
+	// ProblemTypeIdentityMismatch indicates that the "Identity Schema" section disagrees with the resource identity schema.
+	ProblemTypeIdentityMismatch ProblemType = "identity_mismatch"
+)
+
+// Problem is a disagreement between an entry's documentation and its schema.
+type Problem struct {
+	Kind     Kind   `json:"kind"`
+	TypeName string `json:"type_name"`
+	// Path is the path, relative to `website/docs`, of the documentation.
+	Path string `json:"path"`
+	// Line is the line of the documentation at which the problem was found, if any.
+	Line int         `json:"line,omitempty"`
+	Type ProblemType `json:"type"`
+	// Name is the argument, attribute or section concerned, if any.
+	Name   string `json:"name,omitempty"`
+	Detail string `json:"detail,omitempty"`
+}
+
+func (p Problem) String() string {
+	s := p.Path
+	if p.Line > 0 {
+		s += fmt.Sprintf(":%d", p.Line)
+	}
+	s += fmt.Sprintf(": %s %s", p.Kind, p.TypeName)
+	if p.Name != "" {
+		s += fmt.Sprintf(" %q", p.Name)
+	}
+	s += ": " + string(p.Type)
+	if p.Detail != "" {
+		s += " (" + p.Detail + ")"
+	}
+
+	return s
+}
+
+// Check checks an entry's documentation, which is nil if there is none, against its schema.
+func Check(e *Entry, doc *Doc) []Problem {
+	c := checker{
+		entry: e,
+		doc:   doc,
+	}
+
+	if doc == nil {
+		c.report(0, ProblemTypeDocMissing, "", "")
+		return c.problems
+	}
+
+	c.checkArguments()
+	if e.Kind != KindAction && e.Kind != KindListResource {
+		c.checkAttributes()
+	}
+	if e.Kind == KindResource {
+		c.checkImport()
+	}
+
+	return c.problems
+}
+
+type checker struct {
+	entry    *Entry
+	doc      *Doc
+	problems []Problem
+}
+
+func (c *checker) report(line int, typ ProblemType, name, detail string) {
+	c.problems = append(c.problems, Problem{
+		Kind:     c.entry.Kind,
+		TypeName: c.entry.TypeName,
+		Path:     c.entry.DocPath(),
+		Line:     line,
+		Type:     typ,
+		Name:     name,
+		Detail:   detail,
+	})
+}
+
+// checkArguments checks that every argument and block, at any depth, is documented in the "Argument Reference" section,
+// that top-level arguments are correctly labelled as required or optional,
+// and that every documented name is in the schema.
+func (c *checker) checkArguments() {
+	section := c.doc.Section(sectionArgumentReference)
+	if section == nil {
+		c.report(0, ProblemTypeSectionMissing, sectionArgumentReference, "")
+		return
+	}
+
+	documented := make(map[string]bool)
+	for _, v := range c.doc.Bullets(section) {
+		documented[v.Name] = true
+	}
+	// Optional and computed top-level arguments, e.g. data sources' `tags`, are often documented as attributes.
+	documentedAsAttribute := make(map[string]bool)
+	for _, v := range c.doc.Bullets(c.doc.Section(sectionAttributeReference)) {
+		documentedAsAttribute[v.Name] = true
+	}
+
+	var walk func(*tfprotov5.SchemaBlock, string)
+	walk = func(block *tfprotov5.SchemaBlock, path string) {
+		for _, f := range fields(block) {
+			// SDKv2 resources' `id` and `tags_all` attributes are optional and computed.
+			if !f.Configurable() || path == "" && (f.Name == names.AttrID || f.Name == names.AttrTagsAll) {
+				continue
+			}
+
+			if !documented[f.Name] && !(path == "" && f.Computed && documentedAsAttribute[f.Name]) {
+				c.report(section.Line, ProblemTypeArgumentUndocumented, path+f.Name, "")
+			}
+
+			if f.Block != nil {
+				walk(f.Block.Block, path+f.Name+".")
+			}
+		}
+	}
+	walk(c.entry.Schema.Block, "")
+
+	// Only the top-level list items of the section itself document top-level arguments.
+	for _, f := range fields(c.entry.Schema.Block) {
+		if !f.Configurable() {
+			continue
+		}
+
+		for _, v := range section.Bullets {
+			if v.Indented || v.Name != f.Name || v.Label == "" {
+				continue
+			}
+
+			if want := requiredLabel(f); v.Label != want {
+				c.report(v.Line, ProblemTypeRequiredMismatch, f.Name, fmt.Sprintf("documented as %s, schema is %s", v.Label, want))
+			}
+			break
+		}
+	}
+
+	c.checkNamesKnown(section)
+}
+
+// checkAttributes checks that every computed-only top-level attribute, other than `id`, is documented and that every documented name is in the schema.
+func (c *checker) checkAttributes() {
+	section := c.doc.Section(sectionAttributeReference)
+
+	documented := make(map[string]bool)
+	for _, title := range []string{sectionArgumentReference, sectionAttributeReference} {
+		for _, v := range c.doc.Bullets(c.doc.Section(title)) {
+			documented[v.Name] = true
+		}
+	}
+
+	var undocumented []string
+	for _, f := range fields(c.entry.Schema.Block) {
+		// The SDKv2 `id` attribute is implicit and needn't be documented.
+		if f.Name == names.AttrID || f.Configurable() && f.Name != names.AttrTagsAll || documented[f.Name] {
+			continue
+		}
+		undocumented = append(undocumented, f.Name)
+	}
+
+	if len(undocumented) == 0 {
+		c.checkNamesKnown(section)
+		return
+	}
+
+	if section == nil {
+		c.report(0, ProblemTypeSectionMissing, sectionAttributeReference, "")
+		return
+	}
+
+	for _, v := range undocumented {
+		c.report(section.Line, ProblemTypeAttributeUndocumented, v, "")
+	}
+
+	c.checkNamesKnown(section)
+}
+
+// checkImport checks that an importable resource's documentation has an "Import" section
+// and that any "Identity Schema" subsection agrees with the resource identity schema.
+func (c *checker) checkImport() {
+	e := c.entry
+
+	if !e.Importable {
+		return
+	}
+
+	section := c.doc.Section(sectionImport)
+	if section == nil {
+		c.report(0, ProblemTypeSectionMissing, sectionImport, "")
+		return
+	}
+
+	if e.Identity == nil {
+		return
+	}
+
+	var identitySection *Section
+	for _, v := range c.doc.Subsections(section) {
+		if strings.EqualFold(v.Title, sectionIdentitySchema) {
+			identitySection = v
+			break
+		}
+	}
+
+	if identitySection == nil {
+		c.report(section.Line, ProblemTypeSectionMissing, sectionIdentitySchema, "")
+		return
+	}
+
+	documented := make(map[string]*Bullet)
+	labels := make(map[string]string)
+	for _, v := range c.doc.Subsections(identitySection) {
+		for _, b := range v.Bullets {
+			documented[b.Name] = b
+			labels[b.Name] = v.Title
+		}
+	}
+
+	for _, v := range e.Identity.IdentityAttributes {
+		want := "Optional"
+		if v.RequiredForImport {
+			want = "Required"
+		}
+
+		b, ok := documented[v.Name]
+		switch {
+		case !ok:
+			c.report(identitySection.Line, ProblemTypeIdentityMismatch, v.Name, "undocumented")
+		case !strings.EqualFold(labels[v.Name], want):
+			c.report(b.Line, ProblemTypeIdentityMismatch, v.Name, fmt.Sprintf("documented as %s, schema is %s", labels[v.Name], want))
+		}
+	}
+
+	for _, v := range c.doc.Bullets(identitySection) {
+		if !slices.ContainsFunc(e.Identity.IdentityAttributes, func(a *tfprotov5.ResourceIdentitySchemaAttribute) bool { return a.Name == v.Name }) {
+			c.report(v.Line, ProblemTypeIdentityMismatch, v.Name, "not in identity schema")
+		}
+	}
+}
+
+// checkNamesKnown checks that every name documented in the specified section and its subsections is in the schema.
+func (c *checker) checkNamesKnown(section *Section) {
+	known := schemaNames(c.entry.Schema.Block)
+
+	for _, v := range c.doc.Bullets(section) {
+		// Lists of valid values, e.g. "* `ACCOUNT` - ...", aren't argument or attribute names.
+		if !attributeNameRegexp.MatchString(v.Name) {
+			continue
+		}
+
+		if !known[documentedName(v.Name)] {
+			c.report(v.Line, ProblemTypeNameUnknown, v.Name, "")
+		}
+	}
+}
+
+var attributeNameRegexp = regexache.MustCompile(`^[a-z][0-9a-z_]*(?:\.(?:[0-9a-z_]+|[%#*]))*$`)
+
+// documentedName returns the schema name for a documented name,
+// e.g. "name" for "setting.0.name" or "tags.%".
+func documentedName(name string) string {
+	parts := strings.Split(name, ".")
+	for i := len(parts) - 1; i >= 0; i-- {
+		switch parts[i] {
+		case "", "%", "#", "*":
+			continue
+		}
+		if strings.Trim(parts[i], "0123456789") == "" {
+			continue
+		}
+
+		return parts[i]
+	}
+
+	return name
+}
+
+func requiredLabel(f field) string {
+	if f.Required {
+		return "Required"
+	}
+
+	return "Optional"
+}
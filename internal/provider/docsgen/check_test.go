@@ -0,0 +1,265 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package docsgen_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/docsgen"
+)
+
+func testEntry() *docsgen.Entry {
+	return &docsgen.Entry{
+		Kind:           docsgen.KindResource,
+		TypeName:       "aws_example_thing",
+		Name:           "Thing",
+		ServicePackage: "example",
+		Schema: &tfprotov5.Schema{
+			Block: &tfprotov5.SchemaBlock{
+				Attributes: []*tfprotov5.SchemaAttribute{
+					{Name: "arn", Type: tftypes.String, Computed: true},
+					{Name: "id", Type: tftypes.String, Optional: true, Computed: true},
+					{Name: "name", Type: tftypes.String, Required: true, Description: "Name of the thing."},
+					{Name: "region", Type: tftypes.String, Optional: true, Computed: true},
+					{Name: "tags", Type: tftypes.Map{ElementType: tftypes.String}, Optional: true},
+					{Name: "tags_all", Type: tftypes.Map{ElementType: tftypes.String}, Optional: true, Computed: true},
+				},
+				BlockTypes: []*tfprotov5.SchemaNestedBlock{
+					{
+						TypeName: "configuration",
+						Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+						MaxItems: 1,
+						Block: &tfprotov5.SchemaBlock{
+							Attributes: []*tfprotov5.SchemaAttribute{
+								{Name: "enabled", Type: tftypes.Bool, Required: true},
+								{Name: "size", Type: tftypes.Number, Optional: true},
+							},
+						},
+					},
+					{
+						TypeName: "timeouts",
+						Nesting:  tfprotov5.SchemaNestedBlockNestingModeSingle,
+						Block: &tfprotov5.SchemaBlock{
+							Attributes: []*tfprotov5.SchemaAttribute{
+								{Name: "create", Type: tftypes.String, Optional: true},
+							},
+						},
+					},
+				},
+			},
+		},
+		Identity: &tfprotov5.ResourceIdentitySchema{
+			IdentityAttributes: []*tfprotov5.ResourceIdentitySchemaAttribute{
+				{Name: "account_id", Type: tftypes.String, OptionalForImport: true},
+				{Name: "region", Type: tftypes.String, OptionalForImport: true},
+				{Name: "name", Type: tftypes.String, RequiredForImport: true},
+			},
+		},
+		Importable: true,
+	}
+}
+
+const testDoc = "---\n" + `subcategory: "Example"
+layout: "aws"
+page_title: "AWS: aws_example_thing"
+---
+
+# Resource: aws_example_thing
+
+## Example Usage
+
+` + "```terraform" + `
+resource "aws_example_thing" "example" {
+  name = "example"
+}
+` + "```" + `
+
+## Argument Reference
+
+The following arguments are required:
+
+* ` + "`name`" + ` - (Required) Name of the thing.
+
+The following arguments are optional:
+
+* ` + "`region`" + ` - (Optional) Region where this resource will be managed.
+* ` + "`configuration`" + ` - (Optional) Configuration. See [` + "`configuration`" + ` Block](#configuration-block) for details.
+* ` + "`tags`" + ` - (Optional) Key-value map of resource tags.
+
+### ` + "`configuration`" + ` Block
+
+The ` + "`configuration`" + ` configuration block supports the following arguments:
+
+* ` + "`enabled`" + ` - (Required) Whether the thing is enabled.
+* ` + "`size`" + ` - (Optional) Size of the thing. Valid values are:
+    * ` + "`SMALL`" + ` - Small.
+    * ` + "`LARGE`" + ` - Large.
+
+## Attribute Reference
+
+This resource exports the following attributes in addition to the arguments above:
+
+* ` + "`arn`" + ` - ARN of the thing.
+* ` + "`id`" + ` - Name of the thing.
+* ` + "`tags_all`" + ` - Map of tags assigned to the resource.
+
+## Import
+
+### Identity Schema
+
+#### Required
+
+* ` + "`name`" + ` (String) Name of the thing.
+
+#### Optional
+
+* ` + "`account_id`" + ` (String) AWS Account where this resource is managed.
+* ` + "`region`" + ` (String) Region where this resource is managed.
+
+Using ` + "`terraform import`" + `, import things using the ` + "`name`" + `. For example:
+
+` + "```console" + `
+% terraform import aws_example_thing.example example
+` + "```" + `
+`
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		entry            func(*docsgen.Entry)
+		doc              func(string) string
+		expectedProblems []docsgen.Problem
+	}{
+		"no problems": {},
+		"no documentation": {
+			doc: func(string) string { return "" },
+			expectedProblems: []docsgen.Problem{
+				{Type: docsgen.ProblemTypeDocMissing},
+			},
+		},
+		"argument and attribute added": {
+			entry: func(e *docsgen.Entry) {
+				e.Schema.Block.Attributes = append(e.Schema.Block.Attributes,
+					&tfprotov5.SchemaAttribute{Name: "description", Type: tftypes.String, Optional: true},
+					&tfprotov5.SchemaAttribute{Name: "status", Type: tftypes.String, Computed: true},
+				)
+				e.Schema.Block.BlockTypes[0].Block.Attributes = append(e.Schema.Block.BlockTypes[0].Block.Attributes,
+					&tfprotov5.SchemaAttribute{Name: "mode", Type: tftypes.String, Optional: true},
+				)
+			},
+			expectedProblems: []docsgen.Problem{
+				{Line: 17, Type: docsgen.ProblemTypeArgumentUndocumented, Name: "configuration.mode"},
+				{Line: 17, Type: docsgen.ProblemTypeArgumentUndocumented, Name: "description"},
+				{Line: 38, Type: docsgen.ProblemTypeAttributeUndocumented, Name: "status"},
+			},
+		},
+		"attribute documented with colon": {
+			doc: func(s string) string {
+				return strings.Replace(s, "* `arn` - ARN", "* `arn`: ARN", 1)
+			},
+		},
+		"id undocumented": {
+			doc: func(s string) string {
+				return strings.Replace(s, "* `id` - Name of the thing.\n", "", 1)
+			},
+		},
+		"optional and computed argument documented as attribute": {
+			entry: func(e *docsgen.Entry) {
+				e.Schema.Block.Attributes[4].Computed = true
+			},
+			doc: func(s string) string {
+				s = strings.Replace(s, "* `tags` - (Optional) Key-value map of resource tags.\n", "", 1)
+				return strings.Replace(s, "## Import", "* `tags` - Map of tags.\n\n## Import", 1)
+			},
+		},
+		"argument removed": {
+			entry: func(e *docsgen.Entry) {
+				e.Schema.Block.Attributes = e.Schema.Block.Attributes[:4]
+			},
+			expectedProblems: []docsgen.Problem{
+				{Line: 27, Type: docsgen.ProblemTypeNameUnknown, Name: "tags"},
+				{Line: 44, Type: docsgen.ProblemTypeNameUnknown, Name: "tags_all"},
+			},
+		},
+		"argument became optional": {
+			entry: func(e *docsgen.Entry) {
+				e.Schema.Block.Attributes[2].Required = false
+				e.Schema.Block.Attributes[2].Optional = true
+			},
+			expectedProblems: []docsgen.Problem{
+				{Line: 21, Type: docsgen.ProblemTypeRequiredMismatch, Name: "name", Detail: "documented as Required, schema is Optional"},
+			},
+		},
+		"region not supported": {
+			entry: func(e *docsgen.Entry) {
+				e.Schema.Block.Attributes = append(e.Schema.Block.Attributes[:3], e.Schema.Block.Attributes[4:]...)
+			},
+			expectedProblems: []docsgen.Problem{
+				{Line: 25, Type: docsgen.ProblemTypeNameUnknown, Name: "region"},
+			},
+		},
+		"identity changed": {
+			entry: func(e *docsgen.Entry) {
+				e.Identity.IdentityAttributes[2].Name = "arn"
+			},
+			expectedProblems: []docsgen.Problem{
+				{Line: 48, Type: docsgen.ProblemTypeIdentityMismatch, Name: "arn", Detail: "undocumented"},
+				{Line: 52, Type: docsgen.ProblemTypeIdentityMismatch, Name: "name", Detail: "not in identity schema"},
+			},
+		},
+		"import section missing": {
+			doc: func(s string) string {
+				s, _, _ = strings.Cut(s, "## Import")
+				return s
+			},
+			expectedProblems: []docsgen.Problem{
+				{Type: docsgen.ProblemTypeSectionMissing, Name: "Import"},
+			},
+		},
+		"not importable": {
+			entry: func(e *docsgen.Entry) {
+				e.Identity = nil
+				e.Importable = false
+			},
+			doc: func(s string) string {
+				s, _, _ = strings.Cut(s, "## Import")
+				return s
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			entry := testEntry()
+			if testCase.entry != nil {
+				testCase.entry(entry)
+			}
+
+			s := testDoc
+			if testCase.doc != nil {
+				s = testCase.doc(s)
+			}
+			var doc *docsgen.Doc
+			if s != "" {
+				doc = docsgen.ParseDoc([]byte(s))
+			}
+
+			for i := range testCase.expectedProblems {
+				p := &testCase.expectedProblems[i]
+				p.Kind, p.TypeName, p.Path = docsgen.KindResource, "aws_example_thing", "r/example_thing.html.markdown"
+			}
+
+			if diff := cmp.Diff(docsgen.Check(entry, doc), testCase.expectedProblems); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// The docsgen command renders the reference sections of website documentation from the provider's schemas
+// and checks existing documentation against them.
+// See README.md for usage.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/docsgen"
+)
+
+func main() {
+	var (
+		websiteDir = flag.String("website-dir", "website/docs", "path of the website documentation directory")
+		kind       = flag.String("kind", "", "only include this kind: resource, data_source, ephemeral_resource, action or list_resource")
+		service    = flag.String("service", "", "only include this service package, e.g. iam")
+		typeName   = flag.String("type", "", "only include this type name, e.g. aws_iam_role")
+		render     = flag.Bool("render", false, "render the reference sections of the documentation to standard output")
+		check      = flag.Bool("check", false, "check the documentation against the schemas")
+		reportPath = flag.String("report", "", "path to write the JSON check report to")
+	)
+	flag.Parse()
+
+	if *render == *check {
+		fatalf("exactly one of -render or -check is required")
+	}
+
+	ctx := context.Background()
+
+	inventory, err := docsgen.Inventory(ctx)
+	if err != nil {
+		fatalf("reading schemas: %s", err)
+	}
+
+	var entries []*docsgen.Entry
+	for _, e := range inventory {
+		if (*kind == "" || string(e.Kind) == *kind) && (*service == "" || e.ServicePackage == *service) && (*typeName == "" || e.TypeName == *typeName) {
+			entries = append(entries, e)
+		}
+	}
+
+	if len(entries) == 0 {
+		fatalf("no matching resources, data sources, ephemeral resources, actions or list resources")
+	}
+
+	if *render {
+		for i, e := range entries {
+			doc, err := readDoc(filepath.Join(*websiteDir, e.DocPath()))
+			if err != nil {
+				fatalf("%s", err)
+			}
+
+			if i > 0 {
+				fmt.Fprintln(os.Stdout)
+			}
+			if len(entries) > 1 {
+				fmt.Fprintf(os.Stdout, "<!-- %s -->\n\n", e.DocPath())
+			}
+			fmt.Fprintln(os.Stdout, docsgen.Render(e, doc))
+		}
+
+		return
+	}
+
+	problems := []docsgen.Problem{}
+	for _, e := range entries {
+		doc, err := readDoc(filepath.Join(*websiteDir, e.DocPath()))
+		if err != nil {
+			fatalf("%s", err)
+		}
+
+		problems = append(problems, docsgen.Check(e, doc)...)
+	}
+
+	if *reportPath != "" {
+		if err := writeJSON(*reportPath, problems); err != nil {
+			fatalf("writing report: %s", err)
+		}
+	}
+
+	for _, v := range problems {
+		fmt.Fprintln(os.Stderr, v)
+	}
+	fmt.Fprintf(os.Stderr, "%d documentation problems in %d schemas\n", len(problems), len(entries))
+
+	if len(problems) > 0 {
+		os.Exit(1)
+	}
+}
+
+// readDoc reads and parses a documentation page, returning nil if it doesn't exist.
+func readDoc(path string) (*docsgen.Doc, error) {
+	b, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading documentation: %w", err)
+	}
+
+	return docsgen.ParseDoc(b), nil
+}
+
+func writeJSON(path string, v any) error {
+	var w io.Writer = os.Stdout
+	if path != "" {
+		f, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		w = f
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(v)
+}
+
+func fatalf(format string, a ...any) {
+	fmt.Fprintf(os.Stderr, "docsgen: "+format+"\n", a...)
+	os.Exit(1)
+}
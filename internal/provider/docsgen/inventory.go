@@ -0,0 +1,200 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package docsgen renders the reference sections of the provider's website documentation from schemas
+// and checks existing documentation against them.
+package docsgen
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// Kind is the kind of a documented schema.
+type Kind string
+
+const (
+	KindResource          Kind = "resource"
+	KindDataSource        Kind = "data_source"
+	KindEphemeralResource Kind = "ephemeral_resource"
+	KindAction            Kind = "action"
+	KindListResource      Kind = "list_resource"
+)
+
+// Directory returns the directory, relative to `website/docs`, containing documentation for this kind.
+func (k Kind) Directory() string {
+	switch k {
+	case KindResource:
+		return "r"
+	case KindDataSource:
+		return "d"
+	case KindEphemeralResource:
+		return "ephemeral-resources"
+	case KindAction:
+		return "actions"
+	case KindListResource:
+		return "list-resources"
+	default:
+		return ""
+	}
+}
+
+// Noun returns the noun used for this kind in documentation, e.g. "data source".
+func (k Kind) Noun() string {
+	return strings.ReplaceAll(string(k), "_", " ")
+}
+
+// Entry is a registered resource, data source, ephemeral resource, action or list resource.
+type Entry struct {
+	Kind           Kind
+	TypeName       string // e.g. "aws_iam_role"
+	Name           string // Friendly name, e.g. "Role"
+	ServicePackage string
+	Schema         *tfprotov5.Schema
+	// Identity is the resource identity schema, if any.
+	Identity *tfprotov5.ResourceIdentitySchema
+	// Importable is whether existing infrastructure can be imported into the resource.
+	Importable bool
+}
+
+// DocPath returns the path, relative to `website/docs`, of the entry's documentation.
+func (e *Entry) DocPath() string {
+	return filepath.Join(e.Kind.Directory(), strings.TrimPrefix(e.TypeName, "aws_")+".html.markdown")
+}
+
+// Inventory returns every resource, data source, ephemeral resource, action and list resource registered by the provider's service packages,
+// sorted by kind and type name.
+// Schemas are read from the muxed Terraform Protocol v5 provider server, so they are identical to those Terraform sees.
+func Inventory(ctx context.Context) ([]*Entry, error) {
+	factory, primary, err := provider.ProtoV5ProviderServerFactory(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("creating provider server: %w", err)
+	}
+
+	server := factory()
+
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("reading provider schema: %w", err)
+	}
+	if err := diagnosticsError(schemas.Diagnostics); err != nil {
+		return nil, fmt.Errorf("reading provider schema: %w", err)
+	}
+
+	identities, err := server.GetResourceIdentitySchemas(ctx, &tfprotov5.GetResourceIdentitySchemasRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("reading resource identity schemas: %w", err)
+	}
+	if err := diagnosticsError(identities.Diagnostics); err != nil {
+		return nil, fmt.Errorf("reading resource identity schemas: %w", err)
+	}
+
+	var entries []*Entry
+	add := func(kind Kind, sp conns.ServicePackage, typeName, name string, schema *tfprotov5.Schema) *Entry {
+		if schema == nil {
+			return nil
+		}
+
+		entry := &Entry{
+			Kind:           kind,
+			TypeName:       typeName,
+			Name:           name,
+			ServicePackage: sp.ServicePackageName(),
+			Schema:         schema,
+		}
+		entries = append(entries, entry)
+
+		return entry
+	}
+
+	for sp := range primary.Meta().(*conns.AWSClient).ServicePackages(ctx) {
+		for _, v := range sp.SDKResources(ctx) {
+			if e := add(KindResource, sp, v.TypeName, v.Name, schemas.ResourceSchemas[v.TypeName]); e != nil {
+				e.Identity = identities.IdentitySchemas[v.TypeName]
+				e.Importable = e.Identity != nil || v.Import.WrappedImport || v.Factory().Importer != nil
+			}
+		}
+
+		for _, v := range sp.FrameworkResources(ctx) {
+			if e := add(KindResource, sp, v.TypeName, v.Name, schemas.ResourceSchemas[v.TypeName]); e != nil {
+				e.Identity = identities.IdentitySchemas[v.TypeName]
+				e.Importable = e.Identity != nil || v.Import.WrappedImport || frameworkImportable(ctx, v)
+			}
+		}
+
+		for _, v := range sp.SDKDataSources(ctx) {
+			add(KindDataSource, sp, v.TypeName, v.Name, schemas.DataSourceSchemas[v.TypeName])
+		}
+
+		for _, v := range sp.FrameworkDataSources(ctx) {
+			add(KindDataSource, sp, v.TypeName, v.Name, schemas.DataSourceSchemas[v.TypeName])
+		}
+
+		if sp, ok := sp.(conns.ServicePackageWithEphemeralResources); ok {
+			for _, v := range sp.EphemeralResources(ctx) {
+				add(KindEphemeralResource, sp, v.TypeName, v.Name, schemas.EphemeralResourceSchemas[v.TypeName])
+			}
+		}
+
+		if sp, ok := sp.(conns.ServicePackageWithActions); ok {
+			for _, v := range sp.Actions(ctx) {
+				if s, ok := schemas.ActionSchemas[v.TypeName]; ok {
+					add(KindAction, sp, v.TypeName, v.Name, s.Schema)
+				}
+			}
+		}
+
+		if sp, ok := sp.(conns.ServicePackageWithFrameworkListResources); ok {
+			for v := range sp.FrameworkListResources(ctx) {
+				add(KindListResource, sp, v.TypeName, v.Name, schemas.ListResourceSchemas[v.TypeName])
+			}
+		}
+
+		if sp, ok := sp.(conns.ServicePackageWithSDKListResources); ok {
+			for v := range sp.SDKListResources(ctx) {
+				add(KindListResource, sp, v.TypeName, v.Name, schemas.ListResourceSchemas[v.TypeName])
+			}
+		}
+	}
+
+	slices.SortFunc(entries, func(a, b *Entry) int {
+		return cmp.Or(cmp.Compare(a.Kind, b.Kind), cmp.Compare(a.TypeName, b.TypeName))
+	})
+
+	return entries, nil
+}
+
+// frameworkImportable returns whether a Plugin Framework resource implements import.
+func frameworkImportable(ctx context.Context, v *inttypes.ServicePackageFrameworkResource) bool {
+	r, err := v.Factory(ctx)
+	if err != nil {
+		return false
+	}
+
+	_, ok := r.(resource.ResourceWithImportState)
+
+	return ok
+}
+
+func diagnosticsError(diags []*tfprotov5.Diagnostic) error {
+	var errs []error
+
+	for _, v := range diags {
+		if v.Severity == tfprotov5.DiagnosticSeverityError {
+			errs = append(errs, fmt.Errorf("%s: %s", v.Summary, v.Detail))
+		}
+	}
+
+	return errors.Join(errs...)
+}
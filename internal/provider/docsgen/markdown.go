@@ -0,0 +1,158 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package docsgen
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+)
+
+const (
+	sectionArgumentReference  = "Argument Reference"
+	sectionAttributeReference = "Attribute Reference"
+	sectionIdentitySchema     = "Identity Schema"
+	sectionImport             = "Import"
+)
+
+// Doc is a parsed documentation page.
+type Doc struct {
+	Sections []*Section
+}
+
+// Section is the content of a documentation page under a heading, excluding its subsections.
+// Any content before the first heading is in a section with level 0.
+type Section struct {
+	Level   int // e.g. 2 for "## Argument Reference"
+	Title   string
+	Line    int
+	Bullets []*Bullet
+}
+
+// Bullet is a list item documenting an argument or attribute, e.g. "* `name` - (Required) Name of the role.".
+type Bullet struct {
+	Name        string
+	Label       string // "Required", "Optional" or "" if there is no label
+	Description string
+	Line        int
+	Indented    bool // Whether the item is in a nested list
+}
+
+var (
+	headingRegexp = regexache.MustCompile(`^(#{1,6})\s+(.*?)\s*#*$`)
+	bulletRegexp  = regexache.MustCompile("^(\\s*)[*-]\\s+`([^`]+)`(?::\\s*|\\s+-\\s*|\\s+|$)(?:\\((Required|Optional)[^)]*\\)\\s*)?(.*)$")
+)
+
+// ParseDoc parses a documentation page.
+func ParseDoc(b []byte) *Doc {
+	doc := &Doc{}
+	section := &Section{}
+	doc.Sections = append(doc.Sections, section)
+
+	var (
+		fenced  bool
+		lineNum int
+		last    *Bullet // The list item that any indented continuation line belongs to
+	)
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for scanner.Scan() {
+		line := scanner.Text()
+		lineNum++
+
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			fenced = !fenced
+			continue
+		}
+		if fenced {
+			continue
+		}
+
+		if m := headingRegexp.FindStringSubmatch(line); m != nil {
+			section = &Section{Level: len(m[1]), Title: m[2], Line: lineNum}
+			doc.Sections = append(doc.Sections, section)
+			last = nil
+			continue
+		}
+
+		if m := bulletRegexp.FindStringSubmatch(line); m != nil {
+			last = &Bullet{
+				Name:        m[2],
+				Label:       m[3],
+				Description: strings.TrimSpace(m[4]),
+				Line:        lineNum,
+				Indented:    m[1] != "",
+			}
+			section.Bullets = append(section.Bullets, last)
+			continue
+		}
+
+		switch trimmed := strings.TrimSpace(line); {
+		case last != nil && trimmed != "" && trimmed != line && !strings.HasPrefix(trimmed, "* ") && !strings.HasPrefix(trimmed, "- "):
+			last.Description = strings.TrimSpace(last.Description + " " + trimmed)
+		default:
+			last = nil
+		}
+	}
+
+	return doc
+}
+
+// Section returns the first level 2 section with the specified title, or nil.
+func (d *Doc) Section(title string) *Section {
+	for _, v := range d.Sections {
+		if v.Level == 2 && strings.EqualFold(v.Title, title) {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// Subsections returns the specified section and all its subsections.
+func (d *Doc) Subsections(section *Section) []*Section {
+	if section == nil {
+		return nil
+	}
+
+	for i, v := range d.Sections {
+		if v != section {
+			continue
+		}
+
+		j := i + 1
+		for ; j < len(d.Sections); j++ {
+			if d.Sections[j].Level <= section.Level {
+				break
+			}
+		}
+
+		return d.Sections[i:j]
+	}
+
+	return nil
+}
+
+// Bullets returns all the list items in the specified section and its subsections.
+func (d *Doc) Bullets(section *Section) []*Bullet {
+	var bullets []*Bullet
+
+	for _, v := range d.Subsections(section) {
+		bullets = append(bullets, v.Bullets...)
+	}
+
+	return bullets
+}
+
+// blockHeadingName returns the block name from a block subsection heading, e.g. "`configuration` Block", or "".
+func blockHeadingName(title string) string {
+	if m := blockHeadingRegexp.FindStringSubmatch(title); m != nil {
+		return m[1]
+	}
+
+	return ""
+}
+
+var blockHeadingRegexp = regexache.MustCompile("^`([^`]+)`")
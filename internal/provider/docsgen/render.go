@@ -0,0 +1,342 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package docsgen
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	regionDescription             = "Region where this resource will be [managed](https://docs.aws.amazon.com/general/latest/gr/rande.html#regional-endpoints). Defaults to the Region set in the [provider configuration](https://registry.terraform.io/providers/hashicorp/aws/latest/docs#aws-configuration-reference)."
+	actionRegionDescription       = "Region where this action should be [run](https://docs.aws.amazon.com/general/latest/gr/rande.html#regional-endpoints). Defaults to the Region set in the [provider configuration](https://registry.terraform.io/providers/hashicorp/aws/latest/docs#aws-configuration-reference)."
+	listResourceRegionDescription = "Region to query. Defaults to provider region."
+	tagsDescription               = "Key-value map of resource tags. If configured with a provider [`default_tags` configuration block](https://registry.terraform.io/providers/hashicorp/aws/latest/docs#default_tags-configuration-block) present, tags with matching keys will overwrite those defined at the provider-level."
+	tagsAllDescription            = "Map of tags assigned to the resource, including those inherited from the provider [`default_tags` configuration block](https://registry.terraform.io/providers/hashicorp/aws/latest/docs#default_tags-configuration-block)."
+	identityAccountIDDescription  = "AWS Account where this resource is managed."
+	identityRegionDescription     = "Region where this resource is managed."
+)
+
+// Render returns the "Argument Reference", "Attribute Reference" and "Import" sections of an entry's documentation.
+// Descriptions are taken from the schema or, if it has none, from the existing documentation, if any.
+func Render(e *Entry, existing *Doc) string {
+	r := renderer{
+		entry:        e,
+		descriptions: existingDescriptions(existing),
+		headings:     make(map[string]int),
+	}
+
+	r.argumentReference()
+	if e.Kind != KindAction && e.Kind != KindListResource {
+		r.attributeReference()
+	}
+	if e.Kind == KindResource && e.Importable {
+		r.importSection()
+	}
+
+	return strings.TrimRight(r.sb.String(), "\n")
+}
+
+type renderer struct {
+	sb    strings.Builder
+	entry *Entry
+	// descriptions are the descriptions in the existing documentation, keyed by block and argument or attribute name.
+	descriptions map[[2]string]string
+	// headings counts the block subsection headings rendered for each block name.
+	headings map[string]int
+}
+
+// blockSection is a nested block subsection waiting to be rendered.
+type blockSection struct {
+	heading string
+	name    string
+	block   *tfprotov5.SchemaNestedBlock
+}
+
+func (r *renderer) argumentReference() {
+	var required, optional []field
+	for _, f := range fields(r.entry.Schema.Block) {
+		switch {
+		case f.Name == names.AttrID || f.Name == names.AttrTagsAll:
+		case f.Required:
+			required = append(required, f)
+		case f.Optional:
+			optional = append(optional, f)
+		}
+	}
+	// By convention, `region` is the first optional argument.
+	if i := slices.IndexFunc(optional, func(f field) bool { return f.Name == names.AttrRegion }); i > 0 {
+		region := optional[i]
+		optional = slices.Insert(slices.Delete(optional, i, i+1), 0, region)
+	}
+
+	r.printf("## %s\n\n", sectionArgumentReference)
+
+	var sections []blockSection
+	switch {
+	case len(required) == 0 && len(optional) == 0:
+		r.printf("This %s does not support any arguments.\n\n", r.entry.Kind.Noun())
+	case r.entry.Kind == KindAction || r.entry.Kind == KindListResource:
+		r.printf("This %s supports the following arguments:\n\n", r.entry.Kind.Noun())
+		sections = r.fieldList("", slices.Concat(required, optional), true)
+	default:
+		if len(required) > 0 {
+			r.printf("The following arguments are required:\n\n")
+			sections = append(sections, r.fieldList("", required, true)...)
+		}
+		if len(optional) > 0 {
+			r.printf("The following arguments are optional:\n\n")
+			sections = append(sections, r.fieldList("", optional, true)...)
+		}
+	}
+
+	for len(sections) > 0 {
+		s := sections[0]
+		sections = sections[1:]
+
+		r.printf("### %s\n\n", s.heading)
+		r.printf("The `%s` configuration block supports the following arguments:\n\n", s.name)
+		sections = append(sections, r.fieldList(s.name, fields(s.block.Block), true)...)
+	}
+}
+
+func (r *renderer) attributeReference() {
+	var computed []field
+	for _, f := range fields(r.entry.Schema.Block) {
+		// SDKv2 resources' `id` attribute is optional and computed.
+		if f.Computed && !f.Configurable() || f.Name == names.AttrID || f.Name == names.AttrTagsAll {
+			computed = append(computed, f)
+		}
+	}
+
+	r.printf("## %s\n\n", sectionAttributeReference)
+
+	if len(computed) == 0 {
+		r.printf("This %s exports no additional attributes.\n\n", r.entry.Kind.Noun())
+		return
+	}
+
+	r.printf("This %s exports the following attributes in addition to the arguments above:\n\n", r.entry.Kind.Noun())
+	r.fieldList("", computed, false)
+}
+
+func (r *renderer) importSection() {
+	e := r.entry
+	using := "the import ID"
+
+	r.printf("## %s\n\n", sectionImport)
+
+	if e.Identity != nil {
+		var required, optional []*tfprotov5.ResourceIdentitySchemaAttribute
+		for _, v := range e.Identity.IdentityAttributes {
+			if v.RequiredForImport {
+				required = append(required, v)
+			} else {
+				optional = append(optional, v)
+			}
+		}
+		if len(required) == 1 {
+			using = fmt.Sprintf("the `%s`", required[0].Name)
+		}
+
+		r.printf("In Terraform v1.12.0 and later, the [`import` block](https://developer.hashicorp.com/terraform/language/import) can be used with the `identity` attribute. For example:\n\n")
+		r.printf("```terraform\nimport {\n  to = %s.example\n  identity = {\n", e.TypeName)
+		for _, v := range required {
+			r.printf("    %q = \"example\"\n", v.Name)
+		}
+		r.printf("  }\n}\n\nresource %q \"example\" {\n  ### Configuration omitted for brevity ###\n}\n```\n\n", e.TypeName)
+
+		r.printf("### %s\n\n", sectionIdentitySchema)
+		for _, group := range []struct {
+			heading    string
+			attributes []*tfprotov5.ResourceIdentitySchemaAttribute
+		}{
+			{"Required", required},
+			{"Optional", optional},
+		} {
+			if len(group.attributes) == 0 {
+				continue
+			}
+
+			r.printf("#### %s\n\n", group.heading)
+			for _, v := range group.attributes {
+				description := v.Description
+				switch {
+				case description != "":
+				case v.Name == names.AttrAccountID:
+					description = identityAccountIDDescription
+				case v.Name == names.AttrRegion:
+					description = identityRegionDescription
+				default:
+					description = r.descriptions[[2]string{sectionIdentitySchema, v.Name}]
+				}
+				r.printf("%s\n", strings.TrimSpace(fmt.Sprintf("* `%s` (%s) %s", v.Name, typeName(v.Type), description)))
+			}
+			r.printf("\n")
+		}
+	}
+
+	r.printf("In Terraform v1.5.0 and later, use an [`import` block](https://developer.hashicorp.com/terraform/language/import) to import %s resources using %s. For example:\n\n", e.Name, using)
+	r.printf("```terraform\nimport {\n  to = %s.example\n  id = \"example\"\n}\n```\n\n", e.TypeName)
+	r.printf("Using `terraform import`, import %s resources using %s. For example:\n\n", e.Name, using)
+	r.printf("```console\n%% terraform import %s.example example\n```\n\n", e.TypeName)
+}
+
+// fieldList renders a list of arguments or attributes of the specified block ("" for the top level)
+// and returns the subsections for any nested blocks.
+func (r *renderer) fieldList(blockName string, fields []field, arguments bool) []blockSection {
+	var sections []blockSection
+
+	for _, f := range fields {
+		var sb strings.Builder
+		fmt.Fprintf(&sb, "* `%s` -", f.Name)
+
+		if arguments {
+			var labels []string
+			switch {
+			case f.Required:
+				labels = append(labels, "Required")
+			case f.Optional:
+				labels = append(labels, "Optional")
+			}
+			if f.WriteOnly {
+				labels = append(labels, "Write-Only")
+			}
+			if len(labels) > 0 {
+				fmt.Fprintf(&sb, " (%s)", strings.Join(labels, ", "))
+			}
+		}
+
+		if v := r.description(blockName, f); v != "" {
+			fmt.Fprintf(&sb, " %s", v)
+		}
+
+		if f.Block != nil && f.Configurable() {
+			heading := fmt.Sprintf("`%s` Block", f.Name)
+			if r.headings[f.Name] > 0 && blockName != "" {
+				heading = fmt.Sprintf("`%s` Block for `%s`", f.Name, blockName)
+			}
+			r.headings[f.Name]++
+
+			fmt.Fprintf(&sb, " See [%s](#%s) for details.", heading, anchor(heading))
+			sections = append(sections, blockSection{heading: heading, name: f.Name, block: f.Block})
+		}
+
+		r.printf("%s\n", strings.TrimSuffix(sb.String(), " -"))
+	}
+	r.printf("\n")
+
+	return sections
+}
+
+func (r *renderer) description(blockName string, f field) string {
+	if blockName == "" {
+		switch kind := r.entry.Kind; {
+		case f.Name == names.AttrRegion && kind == KindAction:
+			return actionRegionDescription
+		case f.Name == names.AttrRegion && kind == KindListResource:
+			return listResourceRegionDescription
+		case f.Name == names.AttrRegion:
+			return regionDescription
+		case f.Name == names.AttrTags && kind == KindResource:
+			return tagsDescription
+		case f.Name == names.AttrTagsAll && kind == KindResource:
+			return tagsAllDescription
+		}
+	}
+
+	if f.Description != "" {
+		return f.Description
+	}
+
+	if v, ok := r.descriptions[[2]string{blockName, f.Name}]; ok {
+		return v
+	}
+
+	return r.descriptions[[2]string{"*", f.Name}]
+}
+
+func (r *renderer) printf(format string, a ...any) {
+	fmt.Fprintf(&r.sb, format, a...)
+}
+
+var seeBlockRegexp = regexache.MustCompile(`\s*See \[[^\]]*\]\(#[^)]*\)(?: below)? for details\.?$`)
+
+// existingDescriptions returns the descriptions of arguments and attributes in existing documentation.
+// Descriptions are keyed by block name ("" for the top level) and argument or attribute name,
+// and by "*" and name for the first description of each name.
+func existingDescriptions(doc *Doc) map[[2]string]string {
+	descriptions := make(map[[2]string]string)
+
+	if doc == nil {
+		return descriptions
+	}
+
+	for _, title := range []string{sectionArgumentReference, sectionAttributeReference, sectionImport} {
+		for _, section := range doc.Subsections(doc.Section(title)) {
+			blockName := ""
+			switch {
+			case section.Level == 2:
+			case section.Title == sectionIdentitySchema:
+				blockName = sectionIdentitySchema
+			case section.Level == 4 && (strings.EqualFold(section.Title, "Required") || strings.EqualFold(section.Title, "Optional")):
+				blockName = sectionIdentitySchema
+			default:
+				blockName = blockHeadingName(section.Title)
+				if blockName == "" {
+					continue
+				}
+			}
+
+			for _, v := range section.Bullets {
+				if v.Indented {
+					continue
+				}
+
+				description := seeBlockRegexp.ReplaceAllString(v.Description, "")
+				if blockName == sectionIdentitySchema {
+					// Identity attributes are documented as "* `name` (Type) Description".
+					description = identityTypeRegexp.ReplaceAllString(description, "")
+				}
+				if description == "" {
+					continue
+				}
+
+				key := [2]string{blockName, v.Name}
+				if _, ok := descriptions[key]; !ok {
+					descriptions[key] = description
+				}
+				key = [2]string{"*", v.Name}
+				if _, ok := descriptions[key]; !ok {
+					descriptions[key] = description
+				}
+			}
+		}
+	}
+
+	return descriptions
+}
+
+var identityTypeRegexp = regexache.MustCompile(`^-?\s*\([A-Za-z]+\)\s*`)
+
+// anchor returns the fragment identifier that the Terraform Registry generates for a heading.
+func anchor(heading string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '_', r == '-':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r + 'a' - 'A'
+		case r == ' ':
+			return '-'
+		default:
+			return -1
+		}
+	}, heading)
+}
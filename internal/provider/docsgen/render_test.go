@@ -0,0 +1,171 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package docsgen_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/docsgen"
+)
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		entry    func(*docsgen.Entry)
+		doc      string
+		expected string
+	}{
+		"no documentation": {
+			expected: `## Argument Reference
+
+The following arguments are required:
+
+* ` + "`name`" + ` - (Required) Name of the thing.
+
+The following arguments are optional:
+
+* ` + "`region`" + ` - (Optional) Region where this resource will be [managed](https://docs.aws.amazon.com/general/latest/gr/rande.html#regional-endpoints). Defaults to the Region set in the [provider configuration](https://registry.terraform.io/providers/hashicorp/aws/latest/docs#aws-configuration-reference).
+* ` + "`configuration`" + ` - (Optional) See [` + "`configuration`" + ` Block](#configuration-block) for details.
+* ` + "`tags`" + ` - (Optional) Key-value map of resource tags. If configured with a provider [` + "`default_tags`" + ` configuration block](https://registry.terraform.io/providers/hashicorp/aws/latest/docs#default_tags-configuration-block) present, tags with matching keys will overwrite those defined at the provider-level.
+
+### ` + "`configuration`" + ` Block
+
+The ` + "`configuration`" + ` configuration block supports the following arguments:
+
+* ` + "`enabled`" + ` - (Required)
+* ` + "`size`" + ` - (Optional)
+
+## Attribute Reference
+
+This resource exports the following attributes in addition to the arguments above:
+
+* ` + "`arn`" + `
+* ` + "`id`" + `
+* ` + "`tags_all`" + ` - Map of tags assigned to the resource, including those inherited from the provider [` + "`default_tags`" + ` configuration block](https://registry.terraform.io/providers/hashicorp/aws/latest/docs#default_tags-configuration-block).
+
+## Import
+
+In Terraform v1.12.0 and later, the [` + "`import`" + ` block](https://developer.hashicorp.com/terraform/language/import) can be used with the ` + "`identity`" + ` attribute. For example:
+
+` + "```terraform" + `
+import {
+  to = aws_example_thing.example
+  identity = {
+    "name" = "example"
+  }
+}
+
+resource "aws_example_thing" "example" {
+  ### Configuration omitted for brevity ###
+}
+` + "```" + `
+
+### Identity Schema
+
+#### Required
+
+* ` + "`name`" + ` (String)
+
+#### Optional
+
+* ` + "`account_id`" + ` (String) AWS Account where this resource is managed.
+* ` + "`region`" + ` (String) Region where this resource is managed.
+
+In Terraform v1.5.0 and later, use an [` + "`import`" + ` block](https://developer.hashicorp.com/terraform/language/import) to import Thing resources using the ` + "`name`" + `. For example:
+
+` + "```terraform" + `
+import {
+  to = aws_example_thing.example
+  id = "example"
+}
+` + "```" + `
+
+Using ` + "`terraform import`" + `, import Thing resources using the ` + "`name`" + `. For example:
+
+` + "```console" + `
+% terraform import aws_example_thing.example example
+` + "```",
+		},
+		"existing documentation": {
+			entry: func(e *docsgen.Entry) {
+				e.Identity = nil
+				e.Importable = false
+			},
+			doc: testDoc,
+			expected: `## Argument Reference
+
+The following arguments are required:
+
+* ` + "`name`" + ` - (Required) Name of the thing.
+
+The following arguments are optional:
+
+* ` + "`region`" + ` - (Optional) Region where this resource will be [managed](https://docs.aws.amazon.com/general/latest/gr/rande.html#regional-endpoints). Defaults to the Region set in the [provider configuration](https://registry.terraform.io/providers/hashicorp/aws/latest/docs#aws-configuration-reference).
+* ` + "`configuration`" + ` - (Optional) Configuration. See [` + "`configuration`" + ` Block](#configuration-block) for details.
+* ` + "`tags`" + ` - (Optional) Key-value map of resource tags. If configured with a provider [` + "`default_tags`" + ` configuration block](https://registry.terraform.io/providers/hashicorp/aws/latest/docs#default_tags-configuration-block) present, tags with matching keys will overwrite those defined at the provider-level.
+
+### ` + "`configuration`" + ` Block
+
+The ` + "`configuration`" + ` configuration block supports the following arguments:
+
+* ` + "`enabled`" + ` - (Required) Whether the thing is enabled.
+* ` + "`size`" + ` - (Optional) Size of the thing. Valid values are:
+
+## Attribute Reference
+
+This resource exports the following attributes in addition to the arguments above:
+
+* ` + "`arn`" + ` - ARN of the thing.
+* ` + "`id`" + ` - Name of the thing.
+* ` + "`tags_all`" + ` - Map of tags assigned to the resource, including those inherited from the provider [` + "`default_tags`" + ` configuration block](https://registry.terraform.io/providers/hashicorp/aws/latest/docs#default_tags-configuration-block).`,
+		},
+		"action": {
+			entry: func(e *docsgen.Entry) {
+				e.Kind = docsgen.KindAction
+				e.Schema.Block.Attributes = e.Schema.Block.Attributes[2:4]
+				e.Schema.Block.BlockTypes = nil
+			},
+			expected: `## Argument Reference
+
+This action supports the following arguments:
+
+* ` + "`name`" + ` - (Required) Name of the thing.
+* ` + "`region`" + ` - (Optional) Region where this action should be [run](https://docs.aws.amazon.com/general/latest/gr/rande.html#regional-endpoints). Defaults to the Region set in the [provider configuration](https://registry.terraform.io/providers/hashicorp/aws/latest/docs#aws-configuration-reference).`,
+		},
+		"list resource": {
+			entry: func(e *docsgen.Entry) {
+				e.Kind = docsgen.KindListResource
+				e.Schema.Block.Attributes = e.Schema.Block.Attributes[3:4]
+				e.Schema.Block.BlockTypes = nil
+			},
+			expected: `## Argument Reference
+
+This list resource supports the following arguments:
+
+* ` + "`region`" + ` - (Optional) Region to query. Defaults to provider region.`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			entry := testEntry()
+			if testCase.entry != nil {
+				testCase.entry(entry)
+			}
+
+			var doc *docsgen.Doc
+			if testCase.doc != "" {
+				doc = docsgen.ParseDoc([]byte(testCase.doc))
+			}
+
+			if diff := cmp.Diff(docsgen.Render(entry, doc), testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package docsgen
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// field is an attribute or nested block of a schema block.
+type field struct {
+	Name        string
+	Description string
+	Required    bool
+	Optional    bool
+	Computed    bool
+	WriteOnly   bool
+	Type        tftypes.Type                 // Attributes only
+	Block       *tfprotov5.SchemaNestedBlock // Nested blocks only
+}
+
+// Configurable returns whether the field can be set in configuration.
+func (f field) Configurable() bool {
+	return f.Required || f.Optional
+}
+
+// fields returns the attributes and nested blocks of a schema block, sorted by name.
+// The `timeouts` block is excluded, as it is documented separately.
+func fields(block *tfprotov5.SchemaBlock) []field {
+	if block == nil {
+		return nil
+	}
+
+	var result []field
+
+	for _, v := range block.Attributes {
+		result = append(result, field{
+			Name:        v.Name,
+			Description: v.Description,
+			Required:    v.Required,
+			Optional:    v.Optional,
+			Computed:    v.Computed,
+			WriteOnly:   v.WriteOnly,
+			Type:        v.Type,
+		})
+	}
+
+	for _, v := range block.BlockTypes {
+		if v.TypeName == names.AttrTimeouts {
+			continue
+		}
+
+		f := field{
+			Name:     v.TypeName,
+			Required: v.MinItems > 0,
+			Optional: v.MinItems == 0,
+			Block:    v,
+		}
+		if v.Block != nil {
+			f.Description = v.Block.Description
+			// A block is computed-only if all its attributes and blocks are.
+			f.Computed = !slices.ContainsFunc(fields(v.Block), field.Configurable)
+			f.Required = f.Required && !f.Computed
+			f.Optional = f.Optional && !f.Computed
+		}
+		result = append(result, f)
+	}
+
+	slices.SortFunc(result, func(a, b field) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	return result
+}
+
+// schemaNames returns the names of all attributes and nested blocks at any depth of a schema block,
+// including the attribute names of object types.
+func schemaNames(block *tfprotov5.SchemaBlock) map[string]bool {
+	result := make(map[string]bool)
+
+	var walkType func(tftypes.Type)
+	walkType = func(t tftypes.Type) {
+		switch t := t.(type) {
+		case tftypes.List:
+			walkType(t.ElementType)
+		case tftypes.Set:
+			walkType(t.ElementType)
+		case tftypes.Map:
+			walkType(t.ElementType)
+		case tftypes.Object:
+			for k, v := range t.AttributeTypes {
+				result[k] = true
+				walkType(v)
+			}
+		}
+	}
+
+	var walkBlock func(*tfprotov5.SchemaBlock)
+	walkBlock = func(block *tfprotov5.SchemaBlock) {
+		if block == nil {
+			return
+		}
+
+		for _, v := range block.Attributes {
+			result[v.Name] = true
+			walkType(v.Type)
+		}
+
+		for _, v := range block.BlockTypes {
+			result[v.TypeName] = true
+			walkBlock(v.Block)
+		}
+	}
+
+	walkBlock(block)
+
+	return result
+}
+
+// typeName returns the documented name of an attribute type, e.g. "String".
+func typeName(t tftypes.Type) string {
+	switch t.(type) {
+	case nil:
+		return ""
+	case tftypes.List:
+		return "List"
+	case tftypes.Set:
+		return "Set"
+	case tftypes.Map:
+		return "Map"
+	case tftypes.Object:
+		return "Object"
+	}
+
+	switch {
+	case t.Is(tftypes.String):
+		return "String"
+	case t.Is(tftypes.Number):
+		return "Number"
+	case t.Is(tftypes.Bool):
+		return "Boolean"
+	default:
+		return "Dynamic"
+	}
+}
@@ -423,9 +423,26 @@ func (p *frameworkProvider) Actions(ctx context.Context) []func() action.Action
 func (p *frameworkProvider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
+		tffunction.NewARNIsGlobalFunction,
+		tffunction.NewARNLikeFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewARNResourceTypeFunction,
+		tffunction.NewCIDROverlapsFunction,
+		tffunction.NewCIDRReservedAddressesFunction,
+		tffunction.NewDNSSuffixFunction,
+		tffunction.NewIAMPolicyEqualFunction,
+		tffunction.NewIAMPolicyMergeFunction,
+		tffunction.NewIAMPolicyNormalizeFunction,
+		tffunction.NewScheduleNextFunction,
+		tffunction.NewScheduleValidateFunction,
+		tffunction.NewServiceEndpointFunction,
+		tffunction.NewServicePrincipalFunction,
+		tffunction.NewTagsMergeFunction,
+		tffunction.NewTagsValidateFunction,
+		tffunction.NewTagsWithoutAWSPrefixFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 		tffunction.NewUserAgentFunction,
+		tffunction.NewVPCSubnetPlanFunction,
 	}
 }
 
@@ -0,0 +1,128 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// The schemacompat command dumps the provider's schemas and reports breaking changes between dumps.
+// See README.md for usage.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/schemacompat"
+)
+
+func main() {
+	var (
+		dumpPath        = flag.String("dump", "", "path to write the schema dump of this source tree to")
+		providerVersion = flag.String("provider-version", "", "provider version to record in the schema dump (default is the built provider's version)")
+		oldPath         = flag.String("old", "", "path of the schema dump to compare against, e.g. that of the previous release")
+		newPath         = flag.String("new", "", "path of the schema dump to compare (default is the schemas of this source tree)")
+		reportPath      = flag.String("report", "", "path to write the JSON report to (default is standard output)")
+	)
+	flag.Parse()
+
+	ctx := context.Background()
+
+	if *dumpPath != "" {
+		dump := dumpSchemas(ctx, *providerVersion)
+
+		if err := writeJSON(*dumpPath, dump); err != nil {
+			fatalf("writing schema dump: %s", err)
+		}
+
+		return
+	}
+
+	if *oldPath == "" {
+		fatalf("one of -dump or -old is required")
+	}
+
+	old, err := readDump(*oldPath)
+	if err != nil {
+		fatalf("%s", err)
+	}
+
+	var new *schemacompat.ProviderSchema
+	if *newPath != "" {
+		new, err = readDump(*newPath)
+		if err != nil {
+			fatalf("%s", err)
+		}
+	} else {
+		new = dumpSchemas(ctx, *providerVersion)
+	}
+
+	report := schemacompat.Compare(old, new)
+
+	if err := writeJSON(*reportPath, report); err != nil {
+		fatalf("writing report: %s", err)
+	}
+
+	for _, v := range report.BreakingChanges {
+		fmt.Fprintln(os.Stderr, v)
+	}
+	fmt.Fprintf(os.Stderr, "%d breaking changes\n", len(report.BreakingChanges))
+
+	if len(report.BreakingChanges) > 0 {
+		os.Exit(1)
+	}
+}
+
+func dumpSchemas(ctx context.Context, providerVersion string) *schemacompat.ProviderSchema {
+	dump, err := schemacompat.Dump(ctx)
+	if err != nil {
+		fatalf("dumping schemas: %s", err)
+	}
+
+	if providerVersion != "" {
+		dump.ProviderVersion = providerVersion
+	}
+
+	return dump
+}
+
+func readDump(path string) (*schemacompat.ProviderSchema, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading schema dump: %w", err)
+	}
+
+	var dump schemacompat.ProviderSchema
+	if err := json.Unmarshal(b, &dump); err != nil {
+		return nil, fmt.Errorf("reading schema dump (%s): %w", path, err)
+	}
+
+	if dump.FormatVersion != schemacompat.FormatVersion {
+		return nil, fmt.Errorf("reading schema dump (%s): unsupported format version %q", path, dump.FormatVersion)
+	}
+
+	return &dump, nil
+}
+
+func writeJSON(path string, v any) error {
+	var w io.Writer = os.Stdout
+	if path != "" {
+		f, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		w = f
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(v)
+}
+
+func fatalf(format string, a ...any) {
+	fmt.Fprintf(os.Stderr, "schemacompat: "+format+"\n", a...)
+	os.Exit(1)
+}
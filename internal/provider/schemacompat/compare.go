@@ -0,0 +1,299 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schemacompat
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+)
+
+// ChangeType is the type of a breaking schema change.
+type ChangeType string
+
+const (
+	// ChangeTypeSchemaRemoved indicates that a resource, data source or ephemeral resource was removed.
+	ChangeTypeSchemaRemoved ChangeType = "schema_removed"
+	// ChangeTypeAttributeRemoved indicates that an attribute or block was removed.
+	ChangeTypeAttributeRemoved ChangeType = "attribute_removed"
+	// ChangeTypeAttributeRequired indicates that an attribute became required, a required attribute was added,
+	// or a block's minimum number of items was increased.
+	ChangeTypeAttributeRequired ChangeType = "attribute_required"
+	// ChangeTypeAttributeTypeChanged indicates that an attribute's type or a block's nesting mode changed.
+	ChangeTypeAttributeTypeChanged ChangeType = "attribute_type_changed"
+	// ChangeTypeForceNewAdded indicates that a change to an existing attribute or block now forces replacement.
+	ChangeTypeForceNewAdded ChangeType = "force_new_added"
+	// ChangeTypeIdentityChanged indicates that a resource's identity schema changed incompatibly.
+	ChangeTypeIdentityChanged ChangeType = "identity_changed"
+	// ChangeTypeStateUpgraderMissing indicates that a resource's schema version changed
+	// but state written with the old schema version can't be upgraded.
+	ChangeTypeStateUpgraderMissing ChangeType = "state_upgrader_missing"
+)
+
+// SchemaKind is the kind of schema in which a change was found.
+type SchemaKind string
+
+const (
+	SchemaKindProvider          SchemaKind = "provider"
+	SchemaKindResource          SchemaKind = "resource"
+	SchemaKindDataSource        SchemaKind = "data_source"
+	SchemaKindEphemeralResource SchemaKind = "ephemeral_resource"
+)
+
+// Report is the result of comparing two provider schema dumps.
+type Report struct {
+	OldProviderVersion string   `json:"old_provider_version"`
+	NewProviderVersion string   `json:"new_provider_version"`
+	BreakingChanges    []Change `json:"breaking_changes"`
+}
+
+// Change is a breaking schema change.
+type Change struct {
+	Kind     SchemaKind `json:"kind"`
+	TypeName string     `json:"type_name"`
+	// Attribute is the dot-separated path of the changed attribute or block, if any.
+	Attribute string     `json:"attribute,omitempty"`
+	Type      ChangeType `json:"type"`
+	Old       string     `json:"old,omitempty"`
+	New       string     `json:"new,omitempty"`
+}
+
+func (c Change) String() string {
+	s := fmt.Sprintf("%s %s", c.Kind, c.TypeName)
+	if c.Attribute != "" {
+		s += fmt.Sprintf(" %q", c.Attribute)
+	}
+	s += ": " + string(c.Type)
+	if c.Old != "" || c.New != "" {
+		s += fmt.Sprintf(" (%s -> %s)", c.Old, c.New)
+	}
+
+	return s
+}
+
+// Compare compares the old and new provider schema dumps and reports breaking changes.
+func Compare(old, new *ProviderSchema) *Report {
+	c := comparer{
+		changes: []Change{},
+	}
+
+	if old.Provider != nil && new.Provider != nil {
+		c.compareSchema(SchemaKindProvider, "aws", old.Provider, new.Provider)
+	}
+	c.compareSchemas(SchemaKindResource, old.Resources, new.Resources)
+	c.compareSchemas(SchemaKindDataSource, old.DataSources, new.DataSources)
+	c.compareSchemas(SchemaKindEphemeralResource, old.EphemeralResources, new.EphemeralResources)
+
+	slices.SortFunc(c.changes, func(a, b Change) int {
+		return cmp.Or(
+			cmp.Compare(a.Kind, b.Kind),
+			cmp.Compare(a.TypeName, b.TypeName),
+			cmp.Compare(a.Attribute, b.Attribute),
+			cmp.Compare(a.Type, b.Type),
+		)
+	})
+
+	return &Report{
+		OldProviderVersion: old.ProviderVersion,
+		NewProviderVersion: new.ProviderVersion,
+		BreakingChanges:    c.changes,
+	}
+}
+
+type comparer struct {
+	changes []Change
+}
+
+func (c *comparer) add(kind SchemaKind, typeName, attribute string, changeType ChangeType, old, new string) {
+	c.changes = append(c.changes, Change{
+		Kind:      kind,
+		TypeName:  typeName,
+		Attribute: attribute,
+		Type:      changeType,
+		Old:       old,
+		New:       new,
+	})
+}
+
+func (c *comparer) compareSchemas(kind SchemaKind, old, new map[string]*Schema) {
+	for typeName, o := range old {
+		n, ok := new[typeName]
+		if !ok {
+			c.add(kind, typeName, "", ChangeTypeSchemaRemoved, "", "")
+			continue
+		}
+
+		c.compareSchema(kind, typeName, o, n)
+	}
+}
+
+func (c *comparer) compareSchema(kind SchemaKind, typeName string, old, new *Schema) {
+	c.compareBlock(kind, typeName, "", &old.Block, &new.Block)
+
+	if kind != SchemaKindResource {
+		return
+	}
+
+	if old.Version != new.Version && !slices.Contains(new.StateUpgradeVersions, old.Version) {
+		c.add(kind, typeName, "", ChangeTypeStateUpgraderMissing, strconv.FormatInt(old.Version, 10), strconv.FormatInt(new.Version, 10))
+	}
+
+	c.compareIdentity(kind, typeName, old.Identity, new.Identity)
+}
+
+func (c *comparer) compareBlock(kind SchemaKind, typeName, path string, old, new *Block) {
+	for name, o := range old.Attributes {
+		attribute := attributePath(path, name)
+
+		n, ok := new.Attributes[name]
+		if !ok {
+			if _, ok := new.BlockTypes[name]; ok {
+				c.add(kind, typeName, attribute, ChangeTypeAttributeTypeChanged, "attribute", "block")
+			} else {
+				c.add(kind, typeName, attribute, ChangeTypeAttributeRemoved, "", "")
+			}
+			continue
+		}
+
+		if o, n := compactJSON(o.Type), compactJSON(n.Type); o != n {
+			c.add(kind, typeName, attribute, ChangeTypeAttributeTypeChanged, o, n)
+		}
+		if !o.Required && n.Required {
+			c.add(kind, typeName, attribute, ChangeTypeAttributeRequired, attributeMode(o), attributeMode(n))
+		}
+		if kind == SchemaKindResource && !o.ForceNew && n.ForceNew {
+			c.add(kind, typeName, attribute, ChangeTypeForceNewAdded, "", "")
+		}
+
+		c.compareBlock(kind, typeName, attribute, &Block{Attributes: o.NestedAttributes}, &Block{Attributes: n.NestedAttributes})
+	}
+
+	for name, n := range new.Attributes {
+		if _, ok := old.Attributes[name]; ok {
+			continue
+		}
+		if _, ok := old.BlockTypes[name]; ok {
+			continue
+		}
+
+		if n.Required {
+			c.add(kind, typeName, attributePath(path, name), ChangeTypeAttributeRequired, "", attributeMode(n))
+		}
+	}
+
+	for name, o := range old.BlockTypes {
+		attribute := attributePath(path, name)
+
+		n, ok := new.BlockTypes[name]
+		if !ok {
+			if _, ok := new.Attributes[name]; ok {
+				c.add(kind, typeName, attribute, ChangeTypeAttributeTypeChanged, "block", "attribute")
+			} else {
+				c.add(kind, typeName, attribute, ChangeTypeAttributeRemoved, "", "")
+			}
+			continue
+		}
+
+		if o.Nesting != n.Nesting {
+			c.add(kind, typeName, attribute, ChangeTypeAttributeTypeChanged, o.Nesting, n.Nesting)
+		}
+		if n.MinItems > o.MinItems {
+			c.add(kind, typeName, attribute, ChangeTypeAttributeRequired, minItems(o.MinItems), minItems(n.MinItems))
+		}
+		if kind == SchemaKindResource && !o.ForceNew && n.ForceNew {
+			c.add(kind, typeName, attribute, ChangeTypeForceNewAdded, "", "")
+		}
+
+		c.compareBlock(kind, typeName, attribute, &o.Block, &n.Block)
+	}
+
+	for name, n := range new.BlockTypes {
+		if _, ok := old.BlockTypes[name]; ok {
+			continue
+		}
+		if _, ok := old.Attributes[name]; ok {
+			continue
+		}
+
+		if n.MinItems > 0 {
+			c.add(kind, typeName, attributePath(path, name), ChangeTypeAttributeRequired, "", minItems(n.MinItems))
+		}
+	}
+}
+
+func (c *comparer) compareIdentity(kind SchemaKind, typeName string, old, new *Identity) {
+	// Adding an identity isn't a breaking change.
+	if old == nil {
+		return
+	}
+
+	if new == nil {
+		c.add(kind, typeName, "", ChangeTypeIdentityChanged, "identity", "")
+		return
+	}
+
+	if old.Version != new.Version {
+		c.add(kind, typeName, "", ChangeTypeIdentityChanged, "version "+strconv.FormatInt(old.Version, 10), "version "+strconv.FormatInt(new.Version, 10))
+	}
+
+	for name, o := range old.Attributes {
+		n, ok := new.Attributes[name]
+		if !ok {
+			c.add(kind, typeName, name, ChangeTypeIdentityChanged, compactJSON(o.Type), "")
+			continue
+		}
+
+		if o, n := compactJSON(o.Type), compactJSON(n.Type); o != n {
+			c.add(kind, typeName, name, ChangeTypeIdentityChanged, o, n)
+		}
+		if !o.RequiredForImport && n.RequiredForImport {
+			c.add(kind, typeName, name, ChangeTypeIdentityChanged, "optional_for_import", "required_for_import")
+		}
+	}
+
+	for name, n := range new.Attributes {
+		if _, ok := old.Attributes[name]; !ok {
+			c.add(kind, typeName, name, ChangeTypeIdentityChanged, "", compactJSON(n.Type))
+		}
+	}
+}
+
+// compactJSON returns the specified JSON type constraint without insignificant whitespace, so that types can be compared.
+func compactJSON(b json.RawMessage) string {
+	var buf bytes.Buffer
+
+	if err := json.Compact(&buf, b); err != nil {
+		return string(b)
+	}
+
+	return buf.String()
+}
+
+func attributePath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
+
+func attributeMode(a *Attribute) string {
+	switch {
+	case a.Required:
+		return "required"
+	case a.Optional && a.Computed:
+		return "optional+computed"
+	case a.Optional:
+		return "optional"
+	default:
+		return "computed"
+	}
+}
+
+func minItems(n int64) string {
+	return "min_items " + strconv.FormatInt(n, 10)
+}
@@ -0,0 +1,308 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schemacompat_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/schemacompat"
+)
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	stringType := json.RawMessage(`"string"`)
+	numberType := json.RawMessage(`"number"`)
+
+	testCases := map[string]struct {
+		old, new        map[string]*schemacompat.Schema
+		expectedChanges []schemacompat.Change
+	}{
+		"no changes": {
+			old: map[string]*schemacompat.Schema{
+				"aws_example_thing": {
+					Block: schemacompat.Block{
+						Attributes: map[string]*schemacompat.Attribute{
+							"name":  {Type: stringType, Required: true, ForceNew: true},
+							"zones": {Type: json.RawMessage("[\n  \"list\",\n  \"string\"\n]"), Optional: true},
+						},
+					},
+				},
+			},
+			new: map[string]*schemacompat.Schema{
+				"aws_example_thing": {
+					Block: schemacompat.Block{
+						Attributes: map[string]*schemacompat.Attribute{
+							"name":  {Type: stringType, Required: true, ForceNew: true},
+							"zones": {Type: json.RawMessage(`["list","string"]`), Optional: true},
+						},
+					},
+				},
+			},
+		},
+		"non-breaking changes": {
+			old: map[string]*schemacompat.Schema{
+				"aws_example_thing": {
+					Block: schemacompat.Block{
+						Attributes: map[string]*schemacompat.Attribute{
+							"name":        {Type: stringType, Required: true, ForceNew: true},
+							"description": {Type: stringType, Required: true},
+						},
+					},
+				},
+			},
+			new: map[string]*schemacompat.Schema{
+				"aws_example_thing": {
+					Block: schemacompat.Block{
+						Attributes: map[string]*schemacompat.Attribute{
+							"name":        {Type: stringType, Required: true},
+							"description": {Type: stringType, Optional: true},
+							"arn":         {Type: stringType, Computed: true},
+							"size":        {Type: numberType, Optional: true},
+						},
+					},
+					Identity: &schemacompat.Identity{
+						Attributes: map[string]*schemacompat.IdentityAttribute{
+							"name": {Type: stringType, RequiredForImport: true},
+						},
+					},
+				},
+				"aws_example_other_thing": {},
+			},
+		},
+		"resource removed": {
+			old: map[string]*schemacompat.Schema{
+				"aws_example_thing": {},
+			},
+			new: map[string]*schemacompat.Schema{},
+			expectedChanges: []schemacompat.Change{
+				{Kind: schemacompat.SchemaKindResource, TypeName: "aws_example_thing", Type: schemacompat.ChangeTypeSchemaRemoved},
+			},
+		},
+		"attributes": {
+			old: map[string]*schemacompat.Schema{
+				"aws_example_thing": {
+					Block: schemacompat.Block{
+						Attributes: map[string]*schemacompat.Attribute{
+							"description": {Type: stringType, Optional: true},
+							"name":        {Type: stringType, Optional: true, Computed: true},
+							"size":        {Type: stringType, Optional: true},
+							"zone":        {Type: stringType, Optional: true},
+						},
+					},
+				},
+			},
+			new: map[string]*schemacompat.Schema{
+				"aws_example_thing": {
+					Block: schemacompat.Block{
+						Attributes: map[string]*schemacompat.Attribute{
+							"name":  {Type: stringType, Required: true},
+							"owner": {Type: stringType, Required: true},
+							"size":  {Type: numberType, Optional: true},
+							"zone":  {Type: stringType, Optional: true, ForceNew: true},
+						},
+					},
+				},
+			},
+			expectedChanges: []schemacompat.Change{
+				{Kind: schemacompat.SchemaKindResource, TypeName: "aws_example_thing", Attribute: "description", Type: schemacompat.ChangeTypeAttributeRemoved},
+				{Kind: schemacompat.SchemaKindResource, TypeName: "aws_example_thing", Attribute: "name", Type: schemacompat.ChangeTypeAttributeRequired, Old: "optional+computed", New: "required"},
+				{Kind: schemacompat.SchemaKindResource, TypeName: "aws_example_thing", Attribute: "owner", Type: schemacompat.ChangeTypeAttributeRequired, New: "required"},
+				{Kind: schemacompat.SchemaKindResource, TypeName: "aws_example_thing", Attribute: "size", Type: schemacompat.ChangeTypeAttributeTypeChanged, Old: `"string"`, New: `"number"`},
+				{Kind: schemacompat.SchemaKindResource, TypeName: "aws_example_thing", Attribute: "zone", Type: schemacompat.ChangeTypeForceNewAdded},
+			},
+		},
+		"blocks": {
+			old: map[string]*schemacompat.Schema{
+				"aws_example_thing": {
+					Block: schemacompat.Block{
+						BlockTypes: map[string]*schemacompat.NestedBlock{
+							"config": {
+								Nesting: "list",
+								Block: schemacompat.Block{
+									Attributes: map[string]*schemacompat.Attribute{
+										"mode": {Type: stringType, Optional: true},
+									},
+								},
+							},
+							"rule":   {Nesting: "list"},
+							"target": {Nesting: "list"},
+						},
+					},
+				},
+			},
+			new: map[string]*schemacompat.Schema{
+				"aws_example_thing": {
+					Block: schemacompat.Block{
+						BlockTypes: map[string]*schemacompat.NestedBlock{
+							"config": {
+								Nesting:  "list",
+								MinItems: 1,
+								ForceNew: true,
+								Block: schemacompat.Block{
+									Attributes: map[string]*schemacompat.Attribute{
+										"mode": {Type: stringType, Required: true},
+									},
+								},
+							},
+							"rule": {Nesting: "set"},
+						},
+						Attributes: map[string]*schemacompat.Attribute{
+							"target": {Type: json.RawMessage(`["list",["object",{}]]`), Optional: true},
+						},
+					},
+				},
+			},
+			expectedChanges: []schemacompat.Change{
+				{Kind: schemacompat.SchemaKindResource, TypeName: "aws_example_thing", Attribute: "config", Type: schemacompat.ChangeTypeAttributeRequired, Old: "min_items 0", New: "min_items 1"},
+				{Kind: schemacompat.SchemaKindResource, TypeName: "aws_example_thing", Attribute: "config", Type: schemacompat.ChangeTypeForceNewAdded},
+				{Kind: schemacompat.SchemaKindResource, TypeName: "aws_example_thing", Attribute: "config.mode", Type: schemacompat.ChangeTypeAttributeRequired, Old: "optional", New: "required"},
+				{Kind: schemacompat.SchemaKindResource, TypeName: "aws_example_thing", Attribute: "rule", Type: schemacompat.ChangeTypeAttributeTypeChanged, Old: "list", New: "set"},
+				{Kind: schemacompat.SchemaKindResource, TypeName: "aws_example_thing", Attribute: "target", Type: schemacompat.ChangeTypeAttributeTypeChanged, Old: "block", New: "attribute"},
+			},
+		},
+		"nested attributes": {
+			old: map[string]*schemacompat.Schema{
+				"aws_example_thing": {
+					Block: schemacompat.Block{
+						Attributes: map[string]*schemacompat.Attribute{
+							"settings": {
+								Type:     json.RawMessage(`["list",["object",{"mode":"string","size":"number"}]]`),
+								Optional: true,
+								NestedAttributes: map[string]*schemacompat.Attribute{
+									"mode": {Type: stringType, Optional: true},
+									"size": {Type: numberType, Optional: true},
+								},
+							},
+						},
+					},
+				},
+			},
+			new: map[string]*schemacompat.Schema{
+				"aws_example_thing": {
+					Block: schemacompat.Block{
+						Attributes: map[string]*schemacompat.Attribute{
+							"settings": {
+								Type:     json.RawMessage(`["list",["object",{"mode":"string","size":"number"}]]`),
+								Optional: true,
+								NestedAttributes: map[string]*schemacompat.Attribute{
+									"mode": {Type: stringType, Optional: true, ForceNew: true},
+									"size": {Type: numberType, Required: true},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedChanges: []schemacompat.Change{
+				{Kind: schemacompat.SchemaKindResource, TypeName: "aws_example_thing", Attribute: "settings.mode", Type: schemacompat.ChangeTypeForceNewAdded},
+				{Kind: schemacompat.SchemaKindResource, TypeName: "aws_example_thing", Attribute: "settings.size", Type: schemacompat.ChangeTypeAttributeRequired, Old: "optional", New: "required"},
+			},
+		},
+		"state upgraders": {
+			old: map[string]*schemacompat.Schema{
+				"aws_example_thing":       {Version: 1},
+				"aws_example_other_thing": {Version: 0},
+			},
+			new: map[string]*schemacompat.Schema{
+				"aws_example_thing":       {Version: 2, StateUpgradeVersions: []int64{0, 1}},
+				"aws_example_other_thing": {Version: 2, StateUpgradeVersions: []int64{1}},
+			},
+			expectedChanges: []schemacompat.Change{
+				{Kind: schemacompat.SchemaKindResource, TypeName: "aws_example_other_thing", Type: schemacompat.ChangeTypeStateUpgraderMissing, Old: "0", New: "2"},
+			},
+		},
+		"identity": {
+			old: map[string]*schemacompat.Schema{
+				"aws_example_thing": {
+					Identity: &schemacompat.Identity{
+						Attributes: map[string]*schemacompat.IdentityAttribute{
+							"name":   {Type: stringType, RequiredForImport: true},
+							"region": {Type: stringType, OptionalForImport: true},
+						},
+					},
+				},
+				"aws_example_other_thing": {
+					Identity: &schemacompat.Identity{
+						Attributes: map[string]*schemacompat.IdentityAttribute{
+							"name": {Type: stringType, RequiredForImport: true},
+						},
+					},
+				},
+			},
+			new: map[string]*schemacompat.Schema{
+				"aws_example_thing": {
+					Identity: &schemacompat.Identity{
+						Version: 1,
+						Attributes: map[string]*schemacompat.IdentityAttribute{
+							"id":     {Type: stringType, RequiredForImport: true},
+							"region": {Type: stringType, RequiredForImport: true},
+						},
+					},
+				},
+				"aws_example_other_thing": {},
+			},
+			expectedChanges: []schemacompat.Change{
+				{Kind: schemacompat.SchemaKindResource, TypeName: "aws_example_other_thing", Type: schemacompat.ChangeTypeIdentityChanged, Old: "identity"},
+				{Kind: schemacompat.SchemaKindResource, TypeName: "aws_example_thing", Type: schemacompat.ChangeTypeIdentityChanged, Old: "version 0", New: "version 1"},
+				{Kind: schemacompat.SchemaKindResource, TypeName: "aws_example_thing", Attribute: "id", Type: schemacompat.ChangeTypeIdentityChanged, New: `"string"`},
+				{Kind: schemacompat.SchemaKindResource, TypeName: "aws_example_thing", Attribute: "name", Type: schemacompat.ChangeTypeIdentityChanged, Old: `"string"`},
+				{Kind: schemacompat.SchemaKindResource, TypeName: "aws_example_thing", Attribute: "region", Type: schemacompat.ChangeTypeIdentityChanged, Old: "optional_for_import", New: "required_for_import"},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			old := &schemacompat.ProviderSchema{Resources: testCase.old}
+			new := &schemacompat.ProviderSchema{Resources: testCase.new}
+
+			report := schemacompat.Compare(old, new)
+
+			if diff := cmp.Diff(report.BreakingChanges, testCase.expectedChanges, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestCompareDataSources(t *testing.T) {
+	t.Parallel()
+
+	old := &schemacompat.ProviderSchema{
+		DataSources: map[string]*schemacompat.Schema{
+			"aws_example_thing": {
+				Version: 1,
+				Block: schemacompat.Block{
+					Attributes: map[string]*schemacompat.Attribute{
+						"name": {Type: json.RawMessage(`"string"`), Optional: true},
+					},
+				},
+			},
+		},
+	}
+	new := &schemacompat.ProviderSchema{
+		DataSources: map[string]*schemacompat.Schema{
+			"aws_example_thing": {
+				Version: 2,
+				Block: schemacompat.Block{
+					Attributes: map[string]*schemacompat.Attribute{
+						"name": {Type: json.RawMessage(`"string"`), Optional: true, ForceNew: true},
+					},
+				},
+			},
+		},
+	}
+
+	report := schemacompat.Compare(old, new)
+
+	if got := len(report.BreakingChanges); got != 0 {
+		t.Errorf("Compare() = %v, want no breaking changes", report.BreakingChanges)
+	}
+}
@@ -0,0 +1,413 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package schemacompat dumps the provider's schemas and compares dumps from different provider versions for breaking changes.
+package schemacompat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/dynamicplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/numberplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfstringplanmodifier "github.com/hashicorp/terraform-provider-aws/internal/framework/planmodifiers/stringplanmodifier"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework"
+	"github.com/hashicorp/terraform-provider-aws/version"
+)
+
+// FormatVersion is the version of the schema dump format.
+const FormatVersion = "1"
+
+// ProviderSchema is a dump of the provider's schemas.
+type ProviderSchema struct {
+	FormatVersion      string             `json:"format_version"`
+	ProviderVersion    string             `json:"provider_version"`
+	Provider           *Schema            `json:"provider"`
+	Resources          map[string]*Schema `json:"resources"`
+	DataSources        map[string]*Schema `json:"data_sources"`
+	EphemeralResources map[string]*Schema `json:"ephemeral_resources"`
+}
+
+// Schema is the schema of the provider's configuration, a resource, a data source or an ephemeral resource.
+type Schema struct {
+	Version int64 `json:"version"`
+	// StateUpgradeVersions are the prior schema versions from which a resource's state can be upgraded to Version.
+	StateUpgradeVersions []int64   `json:"state_upgrade_versions,omitempty"`
+	Block                Block     `json:"block"`
+	Identity             *Identity `json:"identity,omitempty"`
+}
+
+type Block struct {
+	Attributes map[string]*Attribute   `json:"attributes,omitempty"`
+	BlockTypes map[string]*NestedBlock `json:"block_types,omitempty"`
+}
+
+type Attribute struct {
+	// Type is the attribute's type in Terraform's JSON type constraint syntax.
+	Type      json.RawMessage `json:"type"`
+	Required  bool            `json:"required,omitempty"`
+	Optional  bool            `json:"optional,omitempty"`
+	Computed  bool            `json:"computed,omitempty"`
+	Sensitive bool            `json:"sensitive,omitempty"`
+	ForceNew  bool            `json:"force_new,omitempty"`
+	// NestedAttributes are the attributes of a Plugin Framework nested attribute.
+	NestedAttributes map[string]*Attribute `json:"nested_attributes,omitempty"`
+}
+
+type NestedBlock struct {
+	Block
+	Nesting  string `json:"nesting_mode"`
+	MinItems int64  `json:"min_items,omitempty"`
+	MaxItems int64  `json:"max_items,omitempty"`
+	ForceNew bool   `json:"force_new,omitempty"`
+}
+
+type Identity struct {
+	Version    int64                         `json:"version"`
+	Attributes map[string]*IdentityAttribute `json:"attributes"`
+}
+
+type IdentityAttribute struct {
+	Type              json.RawMessage `json:"type"`
+	RequiredForImport bool            `json:"required_for_import,omitempty"`
+	OptionalForImport bool            `json:"optional_for_import,omitempty"`
+}
+
+// Dump returns the schemas of the provider built from this source tree.
+// Schemas are read from the muxed Terraform Protocol v5 provider server, so they are identical to those Terraform sees.
+// Whether an attribute forces replacement and which prior versions a resource's state can be upgraded from
+// aren't part of the protocol and are read from the Plugin SDK and Plugin Framework resources.
+func Dump(ctx context.Context) (*ProviderSchema, error) {
+	factory, primary, err := provider.ProtoV5ProviderServerFactory(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("creating provider server: %w", err)
+	}
+
+	server := factory()
+
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("reading provider schema: %w", err)
+	}
+	if err := diagnosticsError(schemas.Diagnostics); err != nil {
+		return nil, fmt.Errorf("reading provider schema: %w", err)
+	}
+
+	identities, err := server.GetResourceIdentitySchemas(ctx, &tfprotov5.GetResourceIdentitySchemasRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("reading resource identity schemas: %w", err)
+	}
+	if err := diagnosticsError(identities.Diagnostics); err != nil {
+		return nil, fmt.Errorf("reading resource identity schemas: %w", err)
+	}
+
+	dump := &ProviderSchema{
+		FormatVersion:      FormatVersion,
+		ProviderVersion:    version.ProviderVersion,
+		Provider:           newSchema(schemas.Provider),
+		Resources:          newSchemas(schemas.ResourceSchemas),
+		DataSources:        newSchemas(schemas.DataSourceSchemas),
+		EphemeralResources: newSchemas(schemas.EphemeralResourceSchemas),
+	}
+
+	for typeName, v := range identities.IdentitySchemas {
+		if s, ok := dump.Resources[typeName]; ok {
+			s.Identity = newIdentity(v)
+		}
+	}
+
+	for typeName, r := range primary.ResourcesMap {
+		if s, ok := dump.Resources[typeName]; ok {
+			setSDKForceNew(&s.Block, r.SchemaMap())
+			s.StateUpgradeVersions = sdkStateUpgradeVersions(r)
+		}
+	}
+
+	secondary, err := framework.NewProvider(ctx, primary)
+	if err != nil {
+		return nil, fmt.Errorf("creating Framework provider: %w", err)
+	}
+
+	for _, factory := range secondary.Resources(ctx) {
+		r := factory()
+
+		var metadataResponse resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "aws"}, &metadataResponse)
+
+		s, ok := dump.Resources[metadataResponse.TypeName]
+		if !ok {
+			continue
+		}
+
+		var schemaResponse resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+		if schemaResponse.Diagnostics.HasError() {
+			return nil, fmt.Errorf("reading resource type (%s) schema: %v", metadataResponse.TypeName, schemaResponse.Diagnostics)
+		}
+
+		setFrameworkForceNew(ctx, &s.Block, schemaResponse.Schema.Attributes, schemaResponse.Schema.Blocks)
+
+		if v, ok := r.(resource.ResourceWithUpgradeState); ok {
+			s.StateUpgradeVersions = slices.Sorted(maps.Keys(v.UpgradeState(ctx)))
+		}
+	}
+
+	return dump, nil
+}
+
+func newSchemas(schemas map[string]*tfprotov5.Schema) map[string]*Schema {
+	result := make(map[string]*Schema, len(schemas))
+
+	for typeName, v := range schemas {
+		result[typeName] = newSchema(v)
+	}
+
+	return result
+}
+
+func newSchema(s *tfprotov5.Schema) *Schema {
+	if s == nil {
+		return nil
+	}
+
+	return &Schema{
+		Version: s.Version,
+		Block:   newBlock(s.Block),
+	}
+}
+
+func newBlock(b *tfprotov5.SchemaBlock) Block {
+	var result Block
+
+	if b == nil {
+		return result
+	}
+
+	if len(b.Attributes) > 0 {
+		result.Attributes = make(map[string]*Attribute, len(b.Attributes))
+	}
+	for _, v := range b.Attributes {
+		result.Attributes[v.Name] = &Attribute{
+			Type:      typeJSON(v.Type),
+			Required:  v.Required,
+			Optional:  v.Optional,
+			Computed:  v.Computed,
+			Sensitive: v.Sensitive,
+		}
+	}
+
+	if len(b.BlockTypes) > 0 {
+		result.BlockTypes = make(map[string]*NestedBlock, len(b.BlockTypes))
+	}
+	for _, v := range b.BlockTypes {
+		result.BlockTypes[v.TypeName] = &NestedBlock{
+			Block:    newBlock(v.Block),
+			Nesting:  strings.ToLower(v.Nesting.String()),
+			MinItems: v.MinItems,
+			MaxItems: v.MaxItems,
+		}
+	}
+
+	return result
+}
+
+func newIdentity(s *tfprotov5.ResourceIdentitySchema) *Identity {
+	result := &Identity{
+		Version:    s.Version,
+		Attributes: make(map[string]*IdentityAttribute, len(s.IdentityAttributes)),
+	}
+
+	for _, v := range s.IdentityAttributes {
+		result.Attributes[v.Name] = &IdentityAttribute{
+			Type:              typeJSON(v.Type),
+			RequiredForImport: v.RequiredForImport,
+			OptionalForImport: v.OptionalForImport,
+		}
+	}
+
+	return result
+}
+
+// typeJSON returns the JSON type constraint syntax for the specified type.
+func typeJSON(t tftypes.Type) json.RawMessage {
+	if t == nil {
+		return nil
+	}
+
+	b, err := json.Marshal(t)
+	if err != nil {
+		return nil
+	}
+
+	return b
+}
+
+func diagnosticsError(diags []*tfprotov5.Diagnostic) error {
+	var errs []error
+
+	for _, v := range diags {
+		if v.Severity == tfprotov5.DiagnosticSeverityError {
+			errs = append(errs, fmt.Errorf("%s: %s", v.Summary, v.Detail))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func setSDKForceNew(block *Block, schemaMap map[string]*schema.Schema) {
+	for name, s := range schemaMap {
+		if v, ok := block.Attributes[name]; ok {
+			v.ForceNew = s.ForceNew
+			continue
+		}
+
+		if v, ok := block.BlockTypes[name]; ok {
+			v.ForceNew = s.ForceNew
+
+			if r, ok := s.Elem.(*schema.Resource); ok {
+				setSDKForceNew(&v.Block, r.SchemaMap())
+			}
+		}
+	}
+}
+
+// sdkStateUpgradeVersions returns the prior schema versions from which a Plugin SDK resource's state can be upgraded.
+// Plugin SDK state upgraders are chained, each upgrading state by one version.
+func sdkStateUpgradeVersions(r *schema.Resource) []int64 {
+	upgraders := make(map[int]bool, len(r.StateUpgraders))
+	for _, v := range r.StateUpgraders {
+		upgraders[v.Version] = true
+	}
+
+	var versions []int64
+	v := r.SchemaVersion - 1
+	for ; v >= 0 && upgraders[v]; v-- {
+		versions = append(versions, int64(v))
+	}
+	// MigrateState upgrades legacy flatmap state from any earlier version.
+	if r.MigrateState != nil {
+		for ; v >= 0; v-- {
+			versions = append(versions, int64(v))
+		}
+	}
+
+	slices.Sort(versions)
+
+	return versions
+}
+
+func setFrameworkForceNew(ctx context.Context, block *Block, attributes map[string]resourceschema.Attribute, blocks map[string]resourceschema.Block) {
+	for name, a := range attributes {
+		if v, ok := block.Attributes[name]; ok {
+			setFrameworkAttributeForceNew(ctx, v, a)
+		}
+	}
+
+	for name, b := range blocks {
+		v, ok := block.BlockTypes[name]
+		if !ok {
+			continue
+		}
+
+		v.ForceNew = requiresReplace(b)
+
+		switch b := b.(type) {
+		case resourceschema.ListNestedBlock:
+			setFrameworkForceNew(ctx, &v.Block, b.NestedObject.Attributes, b.NestedObject.Blocks)
+		case resourceschema.SetNestedBlock:
+			setFrameworkForceNew(ctx, &v.Block, b.NestedObject.Attributes, b.NestedObject.Blocks)
+		case resourceschema.SingleNestedBlock:
+			setFrameworkForceNew(ctx, &v.Block, b.Attributes, b.Blocks)
+		}
+	}
+}
+
+func setFrameworkAttributeForceNew(ctx context.Context, attribute *Attribute, a resourceschema.Attribute) {
+	attribute.ForceNew = requiresReplace(a)
+
+	var attributes map[string]resourceschema.Attribute
+	switch a := a.(type) {
+	case resourceschema.ListNestedAttribute:
+		attributes = a.NestedObject.Attributes
+	case resourceschema.MapNestedAttribute:
+		attributes = a.NestedObject.Attributes
+	case resourceschema.SetNestedAttribute:
+		attributes = a.NestedObject.Attributes
+	case resourceschema.SingleNestedAttribute:
+		attributes = a.Attributes
+	default:
+		return
+	}
+
+	// The protocol doesn't describe nested attributes' plan modifiers, so nested attributes are read from the Plugin Framework schema.
+	attribute.NestedAttributes = make(map[string]*Attribute, len(attributes))
+	for name, a := range attributes {
+		v := &Attribute{
+			Type:      typeJSON(a.GetType().TerraformType(ctx)),
+			Required:  a.IsRequired(),
+			Optional:  a.IsOptional(),
+			Computed:  a.IsComputed(),
+			Sensitive: a.IsSensitive(),
+		}
+		setFrameworkAttributeForceNew(ctx, v, a)
+		attribute.NestedAttributes[name] = v
+	}
+}
+
+// requiresReplaceTypes are the types of the plan modifiers that require replacement when a value changes.
+// The Plugin Framework's RequiresReplace, RequiresReplaceIf and RequiresReplaceIfConfigured constructors
+// for each attribute type return plan modifiers of the same type.
+var requiresReplaceTypes = []reflect.Type{
+	reflect.TypeOf(boolplanmodifier.RequiresReplace()),
+	reflect.TypeOf(dynamicplanmodifier.RequiresReplace()),
+	reflect.TypeOf(float32planmodifier.RequiresReplace()),
+	reflect.TypeOf(float64planmodifier.RequiresReplace()),
+	reflect.TypeOf(int32planmodifier.RequiresReplace()),
+	reflect.TypeOf(int64planmodifier.RequiresReplace()),
+	reflect.TypeOf(listplanmodifier.RequiresReplace()),
+	reflect.TypeOf(mapplanmodifier.RequiresReplace()),
+	reflect.TypeOf(numberplanmodifier.RequiresReplace()),
+	reflect.TypeOf(objectplanmodifier.RequiresReplace()),
+	reflect.TypeOf(setplanmodifier.RequiresReplace()),
+	reflect.TypeOf(stringplanmodifier.RequiresReplace()),
+	reflect.TypeOf(tfstringplanmodifier.RequiresReplaceWO("")),
+}
+
+// requiresReplace returns whether a Plugin Framework attribute or block requires replacement when its value changes.
+func requiresReplace(v any) bool {
+	// Each attribute and block type has a PlanModifiers field with a type-specific element type.
+	field := reflect.Indirect(reflect.ValueOf(v)).FieldByName("PlanModifiers")
+	if !field.IsValid() || field.Kind() != reflect.Slice {
+		return false
+	}
+
+	for i := range field.Len() {
+		if m := field.Index(i); !m.IsNil() && slices.Contains(requiresReplaceTypes, m.Elem().Type()) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,133 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schemacompat
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	tfstringplanmodifier "github.com/hashicorp/terraform-provider-aws/internal/framework/planmodifiers/stringplanmodifier"
+)
+
+func TestSetFrameworkForceNew(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	requiresReplaceIfFunc := func(context.Context, planmodifier.StringRequest, *stringplanmodifier.RequiresReplaceIfFuncResponse) {}
+
+	attributes := map[string]resourceschema.Attribute{
+		"always": resourceschema.StringAttribute{
+			Optional:      true,
+			PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
+		},
+		"configured": resourceschema.Int64Attribute{
+			Optional:      true,
+			PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplaceIfConfigured()},
+		},
+		"conditional": resourceschema.StringAttribute{
+			Optional:      true,
+			PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIf(requiresReplaceIfFunc, "custom", "custom")},
+		},
+		"write_only": resourceschema.StringAttribute{
+			Optional:      true,
+			PlanModifiers: []planmodifier.String{tfstringplanmodifier.RequiresReplaceWO("key")},
+		},
+		"updatable": resourceschema.StringAttribute{
+			Optional:      true,
+			PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+		"settings": resourceschema.ListNestedAttribute{
+			Optional: true,
+			NestedObject: resourceschema.NestedAttributeObject{
+				Attributes: map[string]resourceschema.Attribute{
+					"mode": resourceschema.StringAttribute{
+						Required:      true,
+						PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+					},
+					"options": resourceschema.SingleNestedAttribute{
+						Optional: true,
+						Attributes: map[string]resourceschema.Attribute{
+							"size": resourceschema.Int64Attribute{
+								Optional:      true,
+								PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	blocks := map[string]resourceschema.Block{
+		"config": resourceschema.ListNestedBlock{
+			PlanModifiers: []planmodifier.List{listplanmodifier.RequiresReplace()},
+			NestedObject: resourceschema.NestedBlockObject{
+				Attributes: map[string]resourceschema.Attribute{
+					"name": resourceschema.StringAttribute{
+						Optional:      true,
+						PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+					},
+				},
+			},
+		},
+	}
+
+	block := Block{
+		Attributes: map[string]*Attribute{
+			"always":      {},
+			"configured":  {},
+			"conditional": {},
+			"write_only":  {},
+			"updatable":   {},
+			"settings":    {},
+		},
+		BlockTypes: map[string]*NestedBlock{
+			"config": {
+				Block: Block{
+					Attributes: map[string]*Attribute{
+						"name": {},
+					},
+				},
+			},
+		},
+	}
+
+	setFrameworkForceNew(ctx, &block, attributes, blocks)
+
+	forceNew := map[string]bool{}
+	var walk func(path string, attributes map[string]*Attribute)
+	walk = func(path string, attributes map[string]*Attribute) {
+		for name, v := range attributes {
+			forceNew[attributePath(path, name)] = v.ForceNew
+			walk(attributePath(path, name), v.NestedAttributes)
+		}
+	}
+	walk("", block.Attributes)
+	for name, v := range block.BlockTypes {
+		forceNew[name] = v.ForceNew
+		walk(name, v.Attributes)
+	}
+
+	want := map[string]bool{
+		"always":                true,
+		"configured":            true,
+		"conditional":           true,
+		"write_only":            true,
+		"updatable":             false,
+		"settings":              false,
+		"settings.mode":         true,
+		"settings.options":      false,
+		"settings.options.size": true,
+		"config":                true,
+		"config.name":           true,
+	}
+	if diff := cmp.Diff(forceNew, want); diff != "" {
+		t.Errorf("unexpected ForceNew (-got, +want): %s", diff)
+	}
+}
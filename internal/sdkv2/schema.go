@@ -68,31 +68,31 @@ func ComputedOnlyFromResourceSchema(rs map[string]*schema.Schema) map[string]*sc
 	return cs
 }
 
-// IAMPolicyDocumentSchemaRequired returns the standard schema for an optional IAM policy JSON document.
-var IAMPolicyDocumentSchemaOptional = sync.OnceValue(jsonDocumentSchemaOptionalFunc(SuppressEquivalentIAMPolicyDocuments))
+// IAMPolicyDocumentSchemaOptional returns the standard schema for an optional IAM policy JSON document.
+var IAMPolicyDocumentSchemaOptional = sync.OnceValue(jsonDocumentSchemaOptionalFunc(SuppressEquivalentIAMPolicyDocuments, CanonicalizeIAMPolicyDocumentSchemaStateFunc))
 
 // IAMPolicyDocumentSchemaOptionalComputed returns the standard schema for an optional, computed IAM policy JSON document.
-var IAMPolicyDocumentSchemaOptionalComputed = sync.OnceValue(jsonDocumentSchemaOptionalComputedFunc(SuppressEquivalentIAMPolicyDocuments))
+var IAMPolicyDocumentSchemaOptionalComputed = sync.OnceValue(jsonDocumentSchemaOptionalComputedFunc(SuppressEquivalentIAMPolicyDocuments, CanonicalizeIAMPolicyDocumentSchemaStateFunc))
 
 // IAMPolicyDocumentSchemaRequired returns the standard schema for a required IAM policy JSON document.
-var IAMPolicyDocumentSchemaRequired = sync.OnceValue(jsonDocumentSchemaRequiredFunc(SuppressEquivalentIAMPolicyDocuments))
+var IAMPolicyDocumentSchemaRequired = sync.OnceValue(jsonDocumentSchemaRequiredFunc(SuppressEquivalentIAMPolicyDocuments, CanonicalizeIAMPolicyDocumentSchemaStateFunc))
 
 // IAMPolicyDocumentSchemaRequiredForceNew returns the standard schema for a required, force-new IAM policy JSON document.
-var IAMPolicyDocumentSchemaRequiredForceNew = sync.OnceValue(jsonDocumentSchemaRequiredForceNewFunc(SuppressEquivalentIAMPolicyDocuments))
+var IAMPolicyDocumentSchemaRequiredForceNew = sync.OnceValue(jsonDocumentSchemaRequiredForceNewFunc(SuppressEquivalentIAMPolicyDocuments, CanonicalizeIAMPolicyDocumentSchemaStateFunc))
 
 // JSONDocumentSchemaOptional returns the standard schema for an optional JSON document.
-var JSONDocumentSchemaOptional = sync.OnceValue(jsonDocumentSchemaOptionalFunc(SuppressEquivalentJSONDocuments))
+var JSONDocumentSchemaOptional = sync.OnceValue(jsonDocumentSchemaOptionalFunc(SuppressEquivalentJSONDocuments, NormalizeJsonStringSchemaStateFunc))
 
 // JSONDocumentWithEmptySchemaOptional returns the standard schema for an optional JSON document with empty string handling.
-var JSONDocumentWithEmptySchemaOptional = sync.OnceValue(jsonDocumentSchemaOptionalFunc(SuppressEquivalentJSONDocumentsWithEmpty))
+var JSONDocumentWithEmptySchemaOptional = sync.OnceValue(jsonDocumentSchemaOptionalFunc(SuppressEquivalentJSONDocumentsWithEmpty, NormalizeJsonStringSchemaStateFunc))
 
 // JSONDocumentSchemaOptionalForceNew returns the standard schema for an optional, force-new JSON document.
-var JSONDocumentSchemaOptionalForceNew = sync.OnceValue(jsonDocumentSchemaOptionalForceNewFunc(SuppressEquivalentJSONDocuments))
+var JSONDocumentSchemaOptionalForceNew = sync.OnceValue(jsonDocumentSchemaOptionalForceNewFunc(SuppressEquivalentJSONDocuments, NormalizeJsonStringSchemaStateFunc))
 
 // JSONDocumentSchemaRequired returns the standard schema for a required JSON document.
-var JSONDocumentSchemaRequired = sync.OnceValue(jsonDocumentSchemaRequiredFunc(SuppressEquivalentJSONDocuments))
+var JSONDocumentSchemaRequired = sync.OnceValue(jsonDocumentSchemaRequiredFunc(SuppressEquivalentJSONDocuments, NormalizeJsonStringSchemaStateFunc))
 
-func jsonDocumentSchemaOptionalFunc(diffSuppressFunc schema.SchemaDiffSuppressFunc) func() *schema.Schema {
+func jsonDocumentSchemaOptionalFunc(diffSuppressFunc schema.SchemaDiffSuppressFunc, stateFunc schema.SchemaStateFunc) func() *schema.Schema {
 	return func() *schema.Schema {
 		return &schema.Schema{
 			Type:                  schema.TypeString,
@@ -100,12 +100,12 @@ func jsonDocumentSchemaOptionalFunc(diffSuppressFunc schema.SchemaDiffSuppressFu
 			ValidateFunc:          validation.StringIsJSON,
 			DiffSuppressFunc:      diffSuppressFunc,
 			DiffSuppressOnRefresh: true,
-			StateFunc:             NormalizeJsonStringSchemaStateFunc,
+			StateFunc:             stateFunc,
 		}
 	}
 }
 
-func jsonDocumentSchemaOptionalComputedFunc(diffSuppressFunc schema.SchemaDiffSuppressFunc) func() *schema.Schema {
+func jsonDocumentSchemaOptionalComputedFunc(diffSuppressFunc schema.SchemaDiffSuppressFunc, stateFunc schema.SchemaStateFunc) func() *schema.Schema {
 	return func() *schema.Schema {
 		return &schema.Schema{
 			Type:                  schema.TypeString,
@@ -114,12 +114,12 @@ func jsonDocumentSchemaOptionalComputedFunc(diffSuppressFunc schema.SchemaDiffSu
 			ValidateFunc:          validation.StringIsJSON,
 			DiffSuppressFunc:      diffSuppressFunc,
 			DiffSuppressOnRefresh: true,
-			StateFunc:             NormalizeJsonStringSchemaStateFunc,
+			StateFunc:             stateFunc,
 		}
 	}
 }
 
-func jsonDocumentSchemaOptionalForceNewFunc(diffSuppressFunc schema.SchemaDiffSuppressFunc) func() *schema.Schema {
+func jsonDocumentSchemaOptionalForceNewFunc(diffSuppressFunc schema.SchemaDiffSuppressFunc, stateFunc schema.SchemaStateFunc) func() *schema.Schema {
 	return func() *schema.Schema {
 		return &schema.Schema{
 			Type:                  schema.TypeString,
@@ -128,12 +128,12 @@ func jsonDocumentSchemaOptionalForceNewFunc(diffSuppressFunc schema.SchemaDiffSu
 			ValidateFunc:          validation.StringIsJSON,
 			DiffSuppressFunc:      diffSuppressFunc,
 			DiffSuppressOnRefresh: true,
-			StateFunc:             NormalizeJsonStringSchemaStateFunc,
+			StateFunc:             stateFunc,
 		}
 	}
 }
 
-func jsonDocumentSchemaRequiredFunc(diffSuppressFunc schema.SchemaDiffSuppressFunc) func() *schema.Schema {
+func jsonDocumentSchemaRequiredFunc(diffSuppressFunc schema.SchemaDiffSuppressFunc, stateFunc schema.SchemaStateFunc) func() *schema.Schema {
 	return func() *schema.Schema {
 		return &schema.Schema{
 			Type:                  schema.TypeString,
@@ -141,12 +141,12 @@ func jsonDocumentSchemaRequiredFunc(diffSuppressFunc schema.SchemaDiffSuppressFu
 			ValidateFunc:          validation.StringIsJSON,
 			DiffSuppressFunc:      diffSuppressFunc,
 			DiffSuppressOnRefresh: true,
-			StateFunc:             NormalizeJsonStringSchemaStateFunc,
+			StateFunc:             stateFunc,
 		}
 	}
 }
 
-func jsonDocumentSchemaRequiredForceNewFunc(diffSuppressFunc schema.SchemaDiffSuppressFunc) func() *schema.Schema {
+func jsonDocumentSchemaRequiredForceNewFunc(diffSuppressFunc schema.SchemaDiffSuppressFunc, stateFunc schema.SchemaStateFunc) func() *schema.Schema {
 	return func() *schema.Schema {
 		return &schema.Schema{
 			Type:                  schema.TypeString,
@@ -155,7 +155,7 @@ func jsonDocumentSchemaRequiredForceNewFunc(diffSuppressFunc schema.SchemaDiffSu
 			ValidateFunc:          validation.StringIsJSON,
 			DiffSuppressFunc:      diffSuppressFunc,
 			DiffSuppressOnRefresh: true,
-			StateFunc:             NormalizeJsonStringSchemaStateFunc,
+			StateFunc:             stateFunc,
 		}
 	}
 }
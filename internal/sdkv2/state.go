@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
 )
 
 // NormalizeJsonStringSchemaStateFunc normalizes a JSON string value before storing it in state.
@@ -15,6 +16,16 @@ func NormalizeJsonStringSchemaStateFunc(v any) string { // nosemgrep:ci.caps2-in
 	return json
 }
 
+// CanonicalizeIAMPolicyDocumentSchemaStateFunc canonicalizes an IAM policy JSON document before storing it in state.
+// Documents that aren't valid IAM policies are normalized as JSON.
+func CanonicalizeIAMPolicyDocumentSchemaStateFunc(v any) string {
+	if policy, err := iampolicy.Canonicalize(v.(string)); err == nil {
+		return policy
+	}
+
+	return NormalizeJsonStringSchemaStateFunc(v)
+}
+
 // ToLowerSchemaStateFunc converts a string value to lowercase before storing it in state.
 func ToLowerSchemaStateFunc(v any) string {
 	return strings.ToLower(v.(string))
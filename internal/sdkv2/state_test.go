@@ -22,6 +22,40 @@ func TestNormalizeJsonStringSchemaStateFunc(t *testing.T) { // nosemgrep:ci.caps
 	}
 }
 
+func TestCanonicalizeIAMPolicyDocumentSchemaStateFunc(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input any
+		want  string
+	}{
+		"empty": {
+			input: "",
+			want:  "",
+		},
+		"policy": {
+			input: `{"Statement": [{"Resource": ["*"], "Action": ["s3:PutObject", "s3:GetObject"], "Effect": "Allow"}], "Version": "2012-10-17"}`,
+			want:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":"*"}]}`,
+		},
+		"not a policy": {
+			input: `{ "key1": "value1", "Statement": 42}`,
+			want:  `{"Statement":42,"key1":"value1"}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := CanonicalizeIAMPolicyDocumentSchemaStateFunc(testCase.input)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestToLowerSchemaStateFunc(t *testing.T) {
 	t.Parallel()
 
@@ -7,8 +7,8 @@ import (
 	"strings"
 	"time"
 
-	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
 	"github.com/hashicorp/terraform-provider-aws/internal/json"
 )
 
@@ -79,7 +79,7 @@ func SuppressEquivalentIAMPolicyDocuments(k, old, new string, _ *schema.Resource
 		return true
 	}
 
-	equivalent, err := awspolicy.PoliciesAreEquivalent(old, new)
+	equivalent, err := iampolicy.Equivalent(old, new)
 	if err != nil {
 		return false
 	}
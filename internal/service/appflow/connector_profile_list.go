@@ -22,6 +22,7 @@ import (
 
 // Function annotations are used for list resource registration to the Provider. DO NOT EDIT.
 // @SDKListResource("aws_appflow_connector_profile")
+// @Sweeper
 func newConnectorProfileResourceAsListResource() inttypes.ListResourceForSDK {
 	l := listResourceConnectorProfile{}
 	l.SetResourceSchema(resourceConnectorProfile())
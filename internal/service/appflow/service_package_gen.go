@@ -74,6 +74,7 @@ func (p *servicePackage) SDKListResources(ctx context.Context) iter.Seq[*inttype
 			Name:     "Connector Profile",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 			Identity: inttypes.RegionalSingleParameterIdentity(names.AttrName),
+			Sweeper:  &inttypes.ListResourceSweeper{},
 		},
 		{
 			Factory:  newFlowResourceAsListResource,
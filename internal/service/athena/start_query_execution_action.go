@@ -0,0 +1,226 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package athena
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/athena/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// startQueryExecutionPollInterval defines polling cadence for start query execution action.
+const startQueryExecutionPollInterval = 5 * time.Second
+
+// @Action(aws_athena_start_query_execution, name="Start Query Execution")
+func newStartQueryExecutionAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startQueryExecutionAction{}, nil
+}
+
+var (
+	_ action.Action = (*startQueryExecutionAction)(nil)
+)
+
+type startQueryExecutionAction struct {
+	framework.ActionWithModel[startQueryExecutionActionModel]
+}
+
+type startQueryExecutionActionModel struct {
+	framework.WithRegionModel
+	QueryString    types.String `tfsdk:"query_string"`
+	Database       types.String `tfsdk:"database"`
+	Catalog        types.String `tfsdk:"catalog"`
+	WorkGroup      types.String `tfsdk:"workgroup"`
+	OutputLocation types.String `tfsdk:"output_location"`
+	Timeout        types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *startQueryExecutionAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs an Athena SQL query and waits for the query execution to complete.",
+		Attributes: map[string]schema.Attribute{
+			"query_string": schema.StringAttribute{
+				Description: "The SQL query statement to run",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 262144),
+				},
+			},
+			names.AttrDatabase: schema.StringAttribute{
+				Description: "Name of the database used in the query execution",
+				Optional:    true,
+			},
+			"catalog": schema.StringAttribute{
+				Description: "Name of the data catalog used in the query execution",
+				Optional:    true,
+			},
+			"workgroup": schema.StringAttribute{
+				Description: "Name of the workgroup in which the query is run. Defaults to primary",
+				Optional:    true,
+			},
+			"output_location": schema.StringAttribute{
+				Description: "S3 location where query results are stored, for example s3://bucket/path/. Required unless the workgroup specifies a result location",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in minutes to wait for the query execution to complete (default: 30)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AtMost(1440),
+				},
+			},
+		},
+	}
+}
+
+func (a *startQueryExecutionAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startQueryExecutionActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().AthenaClient(ctx)
+
+	timeout := 30 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Minute
+	}
+
+	tflog.Info(ctx, "Starting Athena start query execution action", map[string]any{
+		"workgroup":       config.WorkGroup.ValueString(),
+		names.AttrTimeout: timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "Starting Athena query execution...",
+	})
+
+	input := athena.StartQueryExecutionInput{
+		QueryString: config.QueryString.ValueStringPointer(),
+	}
+
+	if !config.Database.IsNull() || !config.Catalog.IsNull() {
+		input.QueryExecutionContext = &awstypes.QueryExecutionContext{
+			Catalog:  config.Catalog.ValueStringPointer(),
+			Database: config.Database.ValueStringPointer(),
+		}
+	}
+
+	if !config.OutputLocation.IsNull() {
+		input.ResultConfiguration = &awstypes.ResultConfiguration{
+			OutputLocation: config.OutputLocation.ValueStringPointer(),
+		}
+	}
+
+	if !config.WorkGroup.IsNull() {
+		input.WorkGroup = config.WorkGroup.ValueStringPointer()
+	}
+
+	output, err := conn.StartQueryExecution(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start Query Execution",
+			fmt.Sprintf("Could not start Athena query execution: %s", err),
+		)
+		return
+	}
+
+	queryExecutionID := aws.ToString(output.QueryExecutionId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Athena query execution %s started, waiting for completion...", queryExecutionID),
+	})
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.QueryExecution], error) {
+		input := athena.GetQueryExecutionInput{
+			QueryExecutionId: aws.String(queryExecutionID),
+		}
+		output, gerr := conn.GetQueryExecution(ctx, &input)
+		if gerr != nil {
+			return actionwait.FetchResult[*awstypes.QueryExecution]{}, gerr
+		}
+		if output == nil || output.QueryExecution == nil || output.QueryExecution.Status == nil {
+			return actionwait.FetchResult[*awstypes.QueryExecution]{}, fmt.Errorf("query execution %s not found in GetQueryExecution response", queryExecutionID)
+		}
+		return actionwait.FetchResult[*awstypes.QueryExecution]{Status: actionwait.Status(output.QueryExecution.Status.State), Value: output.QueryExecution}, nil
+	}, actionwait.Options[*awstypes.QueryExecution]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(startQueryExecutionPollInterval),
+		ProgressInterval: 30 * time.Second,
+		SuccessStates:    []actionwait.Status{actionwait.Status(awstypes.QueryExecutionStateSucceeded)},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.QueryExecutionStateQueued),
+			actionwait.Status(awstypes.QueryExecutionStateRunning),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.QueryExecutionStateFailed),
+			actionwait.Status(awstypes.QueryExecutionStateCancelled),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Athena query execution %s is currently in state '%s'", queryExecutionID, fr.Status)})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Query Execution",
+				fmt.Sprintf("Athena query execution %s did not complete within %s: %s", queryExecutionID, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			message := err.Error()
+			if result.Value != nil && result.Value.Status.StateChangeReason != nil {
+				message = fmt.Sprintf("%s: %s", message, aws.ToString(result.Value.Status.StateChangeReason))
+			}
+			resp.Diagnostics.AddError(
+				"Query Execution Failed",
+				fmt.Sprintf("Athena query execution %s failed: %s", queryExecutionID, message),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Query Execution State",
+				fmt.Sprintf("Athena query execution %s entered unexpected state: %s", queryExecutionID, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Query Execution",
+				fmt.Sprintf("Error while waiting for Athena query execution %s: %s", queryExecutionID, err),
+			)
+		}
+		return
+	}
+
+	outputLocation := ""
+	if result.Value.ResultConfiguration != nil {
+		outputLocation = aws.ToString(result.Value.ResultConfiguration.OutputLocation)
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Athena query execution %s completed successfully, results stored at %s", queryExecutionID, outputLocation),
+	})
+
+	tflog.Info(ctx, "Athena start query execution action completed successfully", map[string]any{
+		"query_execution_id": queryExecutionID,
+		"output_location":    outputLocation,
+	})
+}
@@ -3,6 +3,10 @@
 
 package cloudfront
 
+import (
+	"time"
+)
+
 // Exports for use in tests only.
 var (
 	ResourceAnycastIPList               = newAnycastIPListResource
@@ -50,4 +54,33 @@ var (
 	FindVPCOriginByID                          = findVPCOriginByID
 
 	WaitDistributionDeployed = waitDistributionDeployed
+
+	ParseRSAPrivateKey = parseRSAPrivateKey
 )
+
+func SignURLWithCannedPolicy(rawURL, keyPairID, privateKey string, expires time.Time) (string, map[string]string, error) {
+	key, err := parseRSAPrivateKey(privateKey)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return signURL(rawURL, keyPairID, key, signedURLPolicy{expires: expires})
+}
+
+func SignURLWithCustomPolicy(rawURL, keyPairID, privateKey string, expires time.Time, ipAddress string) (string, map[string]string, error) {
+	key, err := parseRSAPrivateKey(privateKey)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return signURL(rawURL, keyPairID, key, signedURLPolicy{expires: expires, ipAddress: ipAddress})
+}
+
+func SignURLWithPolicyDocument(rawURL, keyPairID, privateKey, policy string) (string, map[string]string, error) {
+	key, err := parseRSAPrivateKey(privateKey)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return signURL(rawURL, keyPairID, key, signedURLPolicy{custom: []byte(policy)})
+}
@@ -22,6 +22,7 @@ import (
 
 // Function annotations are used for list resource registration to the Provider. DO NOT EDIT.
 // @FrameworkListResource("aws_cloudfront_key_value_store")
+// @Sweeper
 func newKeyValueStoreResourceAsListResource() list.ListResourceWithConfigure {
 	return &listResourceKeyValueStore{}
 }
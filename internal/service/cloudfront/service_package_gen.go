@@ -33,6 +33,17 @@ func (p *servicePackage) Actions(ctx context.Context) []*inttypes.ServicePackage
 	}
 }
 
+func (p *servicePackage) EphemeralResources(ctx context.Context) []*inttypes.ServicePackageEphemeralResource {
+	return []*inttypes.ServicePackageEphemeralResource{
+		{
+			Factory:  newSignedURLEphemeralResource,
+			TypeName: "aws_cloudfront_signed_url",
+			Name:     "Signed URL",
+			Region:   unique.Make(inttypes.ResourceRegionDisabled()),
+		},
+	}
+}
+
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.ServicePackageFrameworkDataSource {
 	return []*inttypes.ServicePackageFrameworkDataSource{
 		{
@@ -154,6 +165,7 @@ func (p *servicePackage) FrameworkListResources(ctx context.Context) iter.Seq[*i
 			Name:     "Key Value Store",
 			Region:   unique.Make(inttypes.ResourceRegionDisabled()),
 			Identity: inttypes.GlobalSingleParameterIdentity(names.AttrName),
+			Sweeper:  &inttypes.ListResourceSweeper{},
 		},
 	})
 }
@@ -0,0 +1,267 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/cloudfront/sign"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameSignedURL = "Ephemeral Resource Signed URL"
+)
+
+// @EphemeralResource(aws_cloudfront_signed_url, name="Signed URL")
+func newSignedURLEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &signedURLEphemeralResource{}, nil
+}
+
+type signedURLEphemeralResource struct {
+	framework.EphemeralResourceWithModel[signedURLEphemeralResourceModel]
+}
+
+func (e *signedURLEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cookies": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"date_greater_than": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot(names.AttrPolicy)),
+				},
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot(names.AttrPolicy)),
+				},
+			},
+			names.AttrIPAddress: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot(names.AttrPolicy)),
+					stringvalidator.Any(
+						fwvalidators.IPv4CIDRNetworkAddress(),
+						fwvalidators.IPv6CIDRNetworkAddress(),
+					),
+				},
+			},
+			"key_pair_id": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrPolicy: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					fwvalidators.JSON(),
+				},
+			},
+			names.AttrPrivateKey: schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+			"signed_url": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrURL: schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (e *signedURLEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	data := signedURLEphemeralResourceModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	rawURL := data.URL.ValueString()
+
+	privateKey, err := parseRSAPrivateKey(data.PrivateKey.ValueString())
+	if err != nil {
+		response.Diagnostics.AddAttributeError(path.Root(names.AttrPrivateKey), "Invalid Private Key", err.Error())
+		return
+	}
+
+	var policy signedURLPolicy
+	switch {
+	case !data.Policy.IsNull():
+		policy = signedURLPolicy{custom: []byte(data.Policy.ValueString())}
+	default:
+		expiration, d := data.Expiration.ValueRFC3339Time()
+		response.Diagnostics.Append(d...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		policy = signedURLPolicy{
+			expires:   expiration,
+			ipAddress: data.IPAddress.ValueString(),
+		}
+
+		if !data.DateGreaterThan.IsNull() {
+			policy.activeAfter, d = data.DateGreaterThan.ValueRFC3339Time()
+			response.Diagnostics.Append(d...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	signedURL, cookies, err := signURL(rawURL, data.KeyPairID.ValueString(), privateKey, policy)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CloudFront, create.ErrActionReading, ERNameSignedURL, rawURL, err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.SignedURL = types.StringValue(signedURL)
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, cookies, &data.Cookies)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type signedURLEphemeralResourceModel struct {
+	Cookies         fwtypes.MapOfString `tfsdk:"cookies"`
+	DateGreaterThan timetypes.RFC3339   `tfsdk:"date_greater_than"`
+	Expiration      timetypes.RFC3339   `tfsdk:"expiration"`
+	IPAddress       types.String        `tfsdk:"ip_address"`
+	KeyPairID       types.String        `tfsdk:"key_pair_id"`
+	Policy          types.String        `tfsdk:"policy"`
+	PrivateKey      types.String        `tfsdk:"private_key"`
+	SignedURL       types.String        `tfsdk:"signed_url"`
+	URL             types.String        `tfsdk:"url"`
+}
+
+// signedURLPolicy describes the policy used to sign a URL.
+// A canned policy is used unless a custom policy document, an activation time or an IP address is specified.
+type signedURLPolicy struct {
+	activeAfter time.Time
+	custom      []byte
+	expires     time.Time
+	ipAddress   string
+}
+
+func (p signedURLPolicy) isCanned() bool {
+	return p.custom == nil && p.activeAfter.IsZero() && p.ipAddress == ""
+}
+
+// policy returns the custom policy for the specified resource.
+func (p signedURLPolicy) policy(resource string) (*sign.Policy, error) {
+	if p.custom != nil {
+		var policy sign.Policy
+
+		decoder := json.NewDecoder(strings.NewReader(string(p.custom)))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&policy); err != nil {
+			return nil, fmt.Errorf("decoding policy: %w", err)
+		}
+
+		return &policy, nil
+	}
+
+	policy := sign.NewCannedPolicy(resource, p.expires)
+	if !p.activeAfter.IsZero() {
+		policy.Statements[0].Condition.DateGreaterThan = sign.NewAWSEpochTime(p.activeAfter)
+	}
+	if p.ipAddress != "" {
+		policy.Statements[0].Condition.IPAddress = &sign.IPAddress{SourceIP: p.ipAddress}
+	}
+
+	return policy, nil
+}
+
+// signURL returns a CloudFront signed URL and the equivalent signed cookies for the specified URL.
+// See https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/private-content-signed-urls.html.
+func signURL(rawURL, keyPairID string, privateKey *rsa.PrivateKey, p signedURLPolicy) (string, map[string]string, error) {
+	urlSigner, cookieSigner := sign.NewURLSigner(keyPairID, privateKey), sign.NewCookieSigner(keyPairID, privateKey)
+
+	var signedURL string
+	var cookies []*http.Cookie
+	var err error
+	if p.isCanned() {
+		if signedURL, err = urlSigner.Sign(rawURL, p.expires); err != nil {
+			return "", nil, fmt.Errorf("signing URL: %w", err)
+		}
+
+		if cookies, err = cookieSigner.Sign(rawURL, p.expires); err != nil {
+			return "", nil, fmt.Errorf("signing cookies: %w", err)
+		}
+	} else {
+		var policy *sign.Policy
+		if policy, err = p.policy(rawURL); err != nil {
+			return "", nil, err
+		}
+
+		if signedURL, err = urlSigner.SignWithPolicy(rawURL, policy); err != nil {
+			return "", nil, fmt.Errorf("signing URL: %w", err)
+		}
+
+		if cookies, err = cookieSigner.SignWithPolicy(policy); err != nil {
+			return "", nil, fmt.Errorf("signing cookies: %w", err)
+		}
+	}
+
+	values := make(map[string]string, len(cookies))
+	for _, cookie := range cookies {
+		values[cookie.Name] = cookie.Value
+	}
+
+	return signedURL, values, nil
+}
+
+// parseRSAPrivateKey parses a PEM encoded PKCS #1 or PKCS #8 RSA private key.
+func parseRSAPrivateKey(v string) (*rsa.PrivateKey, error) {
+	if key, err := sign.LoadPEMPrivKey(strings.NewReader(v)); err == nil {
+		return key, nil
+	}
+
+	key, err := sign.LoadPEMPrivKeyPKCS8(strings.NewReader(v))
+	if err != nil {
+		return nil, fmt.Errorf("parsing private key: %w", err)
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("private key is of type %T, expected RSA", key)
+	}
+
+	return rsaKey, nil
+}
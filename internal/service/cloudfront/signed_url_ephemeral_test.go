@@ -0,0 +1,188 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront_test
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfcloudfront "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestSignURL(t *testing.T) {
+	t.Parallel()
+
+	privateKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	expires := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		rawURL      string
+		ipAddress   string
+		document    string
+		wantPolicy  string
+		wantCanned  bool
+		wantBaseURL string
+		wantErr     bool
+	}{
+		"canned": {
+			rawURL:      "https://d111111abcdef8.cloudfront.net/image.jpg",
+			wantPolicy:  `{"Statement":[{"Resource":"https://d111111abcdef8.cloudfront.net/image.jpg","Condition":{"DateLessThan":{"AWS:EpochTime":1893456000}}}]}`,
+			wantCanned:  true,
+			wantBaseURL: "https://d111111abcdef8.cloudfront.net/image.jpg?",
+		},
+		"canned with query": {
+			rawURL:      "https://d111111abcdef8.cloudfront.net/image.jpg?size=large&color=red",
+			wantPolicy:  `{"Statement":[{"Resource":"https://d111111abcdef8.cloudfront.net/image.jpg?size=large&color=red","Condition":{"DateLessThan":{"AWS:EpochTime":1893456000}}}]}`,
+			wantCanned:  true,
+			wantBaseURL: "https://d111111abcdef8.cloudfront.net/image.jpg?size=large&color=red&",
+		},
+		"custom": {
+			rawURL:      "https://d111111abcdef8.cloudfront.net/image.jpg",
+			ipAddress:   "192.0.2.0/24",
+			wantPolicy:  `{"Statement":[{"Resource":"https://d111111abcdef8.cloudfront.net/image.jpg","Condition":{"IpAddress":{"AWS:SourceIp":"192.0.2.0/24"},"DateLessThan":{"AWS:EpochTime":1893456000}}}]}`,
+			wantBaseURL: "https://d111111abcdef8.cloudfront.net/image.jpg?",
+		},
+		"policy document": {
+			rawURL:      "https://d111111abcdef8.cloudfront.net/image.jpg",
+			document:    `{"Statement":[{"Resource":"https://d111111abcdef8.cloudfront.net/*","Condition":{"DateLessThan":{"AWS:EpochTime":1893456000}}}]}`,
+			wantPolicy:  `{"Statement":[{"Resource":"https://d111111abcdef8.cloudfront.net/*","Condition":{"DateLessThan":{"AWS:EpochTime":1893456000}}}]}`,
+			wantBaseURL: "https://d111111abcdef8.cloudfront.net/image.jpg?",
+		},
+		"policy document unknown element": {
+			rawURL:   "https://d111111abcdef8.cloudfront.net/image.jpg",
+			document: `{"Statement":[{"Resource":"https://d111111abcdef8.cloudfront.net/*","Effect":"Allow"}]}`,
+			wantErr:  true,
+		},
+		"relative URL": {
+			rawURL:  "/image.jpg",
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var signedURL string
+			var cookies map[string]string
+			var err error
+			switch {
+			case testCase.document != "":
+				signedURL, cookies, err = tfcloudfront.SignURLWithPolicyDocument(testCase.rawURL, "K2JCJMDEHXQW5F", privateKey, testCase.document)
+			case testCase.ipAddress != "":
+				signedURL, cookies, err = tfcloudfront.SignURLWithCustomPolicy(testCase.rawURL, "K2JCJMDEHXQW5F", privateKey, expires, testCase.ipAddress)
+			default:
+				signedURL, cookies, err = tfcloudfront.SignURLWithCannedPolicy(testCase.rawURL, "K2JCJMDEHXQW5F", privateKey, expires)
+			}
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("signing URL err %t, want %t: %v", got, want, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if !strings.HasPrefix(signedURL, testCase.wantBaseURL) {
+				t.Fatalf("signed URL %q does not start with %q", signedURL, testCase.wantBaseURL)
+			}
+
+			query, err := url.ParseQuery(strings.TrimPrefix(signedURL, testCase.wantBaseURL))
+			if err != nil {
+				t.Fatalf("parsing signed URL query: %s", err)
+			}
+
+			if got, want := query.Get("Key-Pair-Id"), "K2JCJMDEHXQW5F"; got != want {
+				t.Errorf("Key-Pair-Id = %q, want %q", got, want)
+			}
+
+			if testCase.wantCanned {
+				if got, want := query.Get("Expires"), "1893456000"; got != want {
+					t.Errorf("Expires = %q, want %q", got, want)
+				}
+			} else {
+				if got, want := string(cloudFrontBase64Decode(t, query.Get("Policy"))), testCase.wantPolicy; got != want {
+					t.Errorf("Policy = %q, want %q", got, want)
+				}
+			}
+
+			if got, want := string(cloudFrontBase64Decode(t, cookies["CloudFront-Policy"])), testCase.wantPolicy; got != want {
+				t.Errorf("CloudFront-Policy cookie = %q, want %q", got, want)
+			}
+			if got, want := cookies["CloudFront-Key-Pair-Id"], "K2JCJMDEHXQW5F"; got != want {
+				t.Errorf("CloudFront-Key-Pair-Id cookie = %q, want %q", got, want)
+			}
+			if got, want := cookies["CloudFront-Signature"], query.Get("Signature"); got == "" || got != want {
+				t.Errorf("CloudFront-Signature cookie = %q, want %q", got, want)
+			}
+			if got, want := len(cookies), 3; got != want {
+				t.Errorf("got %d cookies, want %d", got, want)
+			}
+		})
+	}
+}
+
+func cloudFrontBase64Decode(t *testing.T, s string) []byte {
+	t.Helper()
+
+	b, err := base64.StdEncoding.DecodeString(strings.NewReplacer("-", "+", "_", "=", "~", "/").Replace(s))
+	if err != nil {
+		t.Fatalf("decoding %q: %s", s, err)
+	}
+
+	return b
+}
+
+func TestAccCloudFrontSignedURLEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	privateKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck: acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignedURLEphemeralResourceConfig_basic(privateKey),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("signed_url"), knownvalue.StringRegexp(regexache.MustCompile(`^https://example\.cloudfront\.net/index\.html\?Expires=1893456000&Signature=[^&]+&Key-Pair-Id=K2JCJMDEHXQW5F$`))),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("cookies").AtMapKey("CloudFront-Policy"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("cookies").AtMapKey("CloudFront-Key-Pair-Id"), knownvalue.StringExact("K2JCJMDEHXQW5F")),
+				},
+			},
+		},
+	})
+}
+
+func testAccSignedURLEphemeralResourceConfig_basic(privateKey string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_cloudfront_signed_url.test"),
+		fmt.Sprintf(`
+ephemeral "aws_cloudfront_signed_url" "test" {
+  url         = "https://example.cloudfront.net/index.html"
+  key_pair_id = "K2JCJMDEHXQW5F"
+  private_key = "%[1]s"
+  expiration  = "2030-01-01T00:00:00Z"
+}
+`, acctest.TLSPEMEscapeNewlines(privateKey)))
+}
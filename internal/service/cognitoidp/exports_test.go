@@ -32,4 +32,7 @@ var (
 	FindUserPoolClientByTwoPartKey           = findUserPoolClientByTwoPartKey
 	FindUserPoolDomain                       = findUserPoolDomain
 	FindUserPoolUICustomizationByTwoPartKey  = findUserPoolUICustomizationByTwoPartKey
+
+	UserPoolSecretHash    = userPoolSecretHash
+	UserPoolTokenEndpoint = userPoolTokenEndpoint
 )
@@ -0,0 +1,411 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitoidp
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameUserPoolTokens = "Ephemeral Resource User Pool Tokens"
+
+	// authFlowClientCredentials is the OAuth 2.0 client credentials grant, which is served by the user pool domain's token endpoint rather than the Cognito API.
+	authFlowClientCredentials = "CLIENT_CREDENTIALS"
+)
+
+// @EphemeralResource(aws_cognito_user_pool_tokens, name="User Pool Tokens")
+func newUserPoolTokensEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &userPoolTokensEphemeralResource{}, nil
+}
+
+var (
+	_ ephemeral.EphemeralResourceWithValidateConfig = (*userPoolTokensEphemeralResource)(nil)
+)
+
+type userPoolTokensEphemeralResource struct {
+	framework.EphemeralResourceWithModel[userPoolTokensEphemeralResourceModel]
+}
+
+func (e *userPoolTokensEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"auth_flow": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(awstypes.AuthFlowTypeAdminUserPasswordAuth),
+						authFlowClientCredentials,
+						string(awstypes.AuthFlowTypeRefreshTokenAuth),
+						string(awstypes.AuthFlowTypeUserPasswordAuth),
+					),
+				},
+			},
+			names.AttrClientID: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrClientSecret: schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"expires_in": schema.Int64Attribute{
+				Computed: true,
+			},
+			"id_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrPassword: schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot(names.AttrUsername)),
+				},
+			},
+			"refresh_token": schema.StringAttribute{
+				Optional:  true,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"scopes": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"token_type": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrUserPoolID: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrUsername: schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (e *userPoolTokensEphemeralResource) ValidateConfig(ctx context.Context, request ephemeral.ValidateConfigRequest, response *ephemeral.ValidateConfigResponse) {
+	var data userPoolTokensEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.AuthFlow.ValueString() == string(awstypes.AuthFlowTypeRefreshTokenAuth) && !data.ClientSecret.IsNull() && data.Username.IsNull() {
+		response.Diagnostics.Append(refreshTokenAuthUsernameRequiredDiag())
+	}
+}
+
+func (e *userPoolTokensEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	conn := e.Meta().CognitoIDPClient(ctx)
+	data := userPoolTokensEphemeralResourceModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	authFlow := data.AuthFlow.ValueString()
+	clientID := data.ClientID.ValueString()
+	userPoolID := data.UserPoolID.ValueString()
+
+	var required []string
+	switch authFlow {
+	case string(awstypes.AuthFlowTypeAdminUserPasswordAuth):
+		required = []string{names.AttrUserPoolID, names.AttrUsername, names.AttrPassword}
+	case authFlowClientCredentials:
+		required = []string{names.AttrUserPoolID}
+	case string(awstypes.AuthFlowTypeRefreshTokenAuth):
+		required = []string{"refresh_token"}
+	case string(awstypes.AuthFlowTypeUserPasswordAuth):
+		required = []string{names.AttrUsername, names.AttrPassword}
+	}
+	for _, attrName := range required {
+		var v types.String
+		response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root(attrName), &v)...)
+		if v.IsNull() {
+			response.Diagnostics.AddAttributeError(path.Root(attrName), "Missing Required Attribute", fmt.Sprintf("%q is required when auth_flow is %q.", attrName, authFlow))
+		}
+	}
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Look up the app client's secret if it has one and it hasn't been configured.
+	clientSecret := data.ClientSecret.ValueString()
+	if clientSecret == "" && userPoolID != "" {
+		client, err := findUserPoolClientByTwoPartKey(ctx, conn, userPoolID, clientID)
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.CognitoIDP, create.ErrActionReading, ERNameUserPoolTokens, clientID, err),
+				err.Error(),
+			)
+			return
+		}
+
+		clientSecret = aws.ToString(client.ClientSecret)
+	}
+
+	if authFlow == string(awstypes.AuthFlowTypeRefreshTokenAuth) && clientSecret != "" && data.Username.IsNull() {
+		response.Diagnostics.Append(refreshTokenAuthUsernameRequiredDiag())
+		return
+	}
+
+	var tokens userPoolTokens
+	var err error
+	switch authFlow {
+	case authFlowClientCredentials:
+		var userPool *awstypes.UserPoolType
+		userPool, err = findUserPoolByID(ctx, conn, userPoolID)
+		if err != nil {
+			break
+		}
+
+		if aws.ToString(userPool.Domain) == "" && aws.ToString(userPool.CustomDomain) == "" {
+			err = fmt.Errorf("user pool (%s) has no domain", userPoolID)
+			break
+		}
+
+		var tokenEndpoint string
+		tokenEndpoint, err = userPoolTokenEndpoint(userPool, e.Meta().Partition(ctx), e.Meta().Region(ctx))
+		if err != nil {
+			break
+		}
+
+		tokens, err = clientCredentialsTokens(ctx, tokenEndpoint, clientID, clientSecret, fwflex.ExpandFrameworkStringValueSet(ctx, data.Scopes))
+	default:
+		authParameters := make(map[string]string)
+		username := data.Username.ValueString()
+		if username != "" {
+			authParameters["USERNAME"] = username
+		}
+		if v := data.Password.ValueString(); v != "" {
+			authParameters["PASSWORD"] = v
+		}
+		if v := data.RefreshToken.ValueString(); v != "" && authFlow == string(awstypes.AuthFlowTypeRefreshTokenAuth) {
+			authParameters["REFRESH_TOKEN"] = v
+		}
+		if clientSecret != "" {
+			authParameters["SECRET_HASH"] = userPoolSecretHash(username, clientID, clientSecret)
+		}
+
+		tokens, err = initiateAuthTokens(ctx, conn, awstypes.AuthFlowType(authFlow), userPoolID, clientID, authParameters)
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CognitoIDP, create.ErrActionReading, ERNameUserPoolTokens, clientID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.AccessToken = types.StringValue(tokens.AccessToken)
+	data.Expiration = timetypes.NewRFC3339TimeValue(time.Now().UTC().Add(time.Duration(tokens.ExpiresIn) * time.Second))
+	data.ExpiresIn = types.Int64Value(tokens.ExpiresIn)
+	data.IDToken = fwflex.StringValueToFramework(ctx, tokens.IDToken)
+	// A refresh token isn't returned by the REFRESH_TOKEN_AUTH flow.
+	if tokens.RefreshToken != "" {
+		data.RefreshToken = types.StringValue(tokens.RefreshToken)
+	}
+	data.TokenType = types.StringValue(tokens.TokenType)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type userPoolTokensEphemeralResourceModel struct {
+	framework.WithRegionModel
+	AccessToken  types.String        `tfsdk:"access_token"`
+	AuthFlow     types.String        `tfsdk:"auth_flow"`
+	ClientID     types.String        `tfsdk:"client_id"`
+	ClientSecret types.String        `tfsdk:"client_secret"`
+	Expiration   timetypes.RFC3339   `tfsdk:"expiration"`
+	ExpiresIn    types.Int64         `tfsdk:"expires_in"`
+	IDToken      types.String        `tfsdk:"id_token"`
+	Password     types.String        `tfsdk:"password"`
+	RefreshToken types.String        `tfsdk:"refresh_token"`
+	Scopes       fwtypes.SetOfString `tfsdk:"scopes"`
+	TokenType    types.String        `tfsdk:"token_type"`
+	UserPoolID   types.String        `tfsdk:"user_pool_id"`
+	Username     types.String        `tfsdk:"username"`
+}
+
+type userPoolTokens struct {
+	AccessToken  string `json:"access_token"`
+	ExpiresIn    int64  `json:"expires_in"`
+	IDToken      string `json:"id_token"`
+	RefreshToken string `json:"refresh_token"`
+	TokenType    string `json:"token_type"`
+}
+
+func initiateAuthTokens(ctx context.Context, conn *cognitoidentityprovider.Client, authFlow awstypes.AuthFlowType, userPoolID, clientID string, authParameters map[string]string) (userPoolTokens, error) {
+	var result *awstypes.AuthenticationResultType
+	var challengeName awstypes.ChallengeNameType
+
+	if authFlow == awstypes.AuthFlowTypeAdminUserPasswordAuth {
+		input := cognitoidentityprovider.AdminInitiateAuthInput{
+			AuthFlow:       authFlow,
+			AuthParameters: authParameters,
+			ClientId:       aws.String(clientID),
+			UserPoolId:     aws.String(userPoolID),
+		}
+
+		output, err := conn.AdminInitiateAuth(ctx, &input)
+		if err != nil {
+			return userPoolTokens{}, err
+		}
+
+		result, challengeName = output.AuthenticationResult, output.ChallengeName
+	} else {
+		input := cognitoidentityprovider.InitiateAuthInput{
+			AuthFlow:       authFlow,
+			AuthParameters: authParameters,
+			ClientId:       aws.String(clientID),
+		}
+
+		output, err := conn.InitiateAuth(ctx, &input)
+		if err != nil {
+			return userPoolTokens{}, err
+		}
+
+		result, challengeName = output.AuthenticationResult, output.ChallengeName
+	}
+
+	if result == nil {
+		if challengeName != "" {
+			return userPoolTokens{}, fmt.Errorf("authentication requires a response to the %s challenge, which is not supported", challengeName)
+		}
+
+		return userPoolTokens{}, fmt.Errorf("no authentication result returned")
+	}
+
+	return userPoolTokens{
+		AccessToken:  aws.ToString(result.AccessToken),
+		ExpiresIn:    int64(result.ExpiresIn),
+		IDToken:      aws.ToString(result.IdToken),
+		RefreshToken: aws.ToString(result.RefreshToken),
+		TokenType:    aws.ToString(result.TokenType),
+	}, nil
+}
+
+func clientCredentialsTokens(ctx context.Context, tokenEndpoint, clientID, clientSecret string, scopes []string) (userPoolTokens, error) {
+	form := url.Values{
+		"grant_type": []string{"client_credentials"},
+	}
+	if len(scopes) > 0 {
+		form.Set("scope", strings.Join(scopes, " "))
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return userPoolTokens{}, err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.SetBasicAuth(clientID, clientSecret)
+
+	response, err := cleanhttp.DefaultClient().Do(request)
+	if err != nil {
+		return userPoolTokens{}, fmt.Errorf("HTTP POST (%s): %w", tokenEndpoint, err)
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return userPoolTokens{}, fmt.Errorf("reading response body (%s): %w", tokenEndpoint, err)
+	}
+
+	if response.StatusCode != http.StatusOK {
+		var tokenError struct {
+			Error string `json:"error"`
+		}
+		_ = json.Unmarshal(body, &tokenError)
+
+		return userPoolTokens{}, fmt.Errorf("HTTP POST (%s): %s: %s", tokenEndpoint, response.Status, tokenError.Error)
+	}
+
+	var tokens userPoolTokens
+	if err := json.Unmarshal(body, &tokens); err != nil {
+		return userPoolTokens{}, fmt.Errorf("decoding response body (%s): %w", tokenEndpoint, err)
+	}
+
+	return tokens, nil
+}
+
+// userPoolTokenEndpoint returns the OAuth 2.0 token endpoint of the specified user pool's domain.
+// A custom domain is preferred over the prefix domain.
+// See https://docs.aws.amazon.com/cognito/latest/developerguide/cognito-user-pools-assign-domain.html.
+func userPoolTokenEndpoint(userPool *awstypes.UserPoolType, partition, region string) (string, error) {
+	if v := aws.ToString(userPool.CustomDomain); v != "" {
+		return fmt.Sprintf("https://%s/oauth2/token", v), nil
+	}
+
+	var host string
+	switch prefix := aws.ToString(userPool.Domain); partition {
+	case endpoints.AwsPartitionID:
+		host = fmt.Sprintf("%s.auth.%s.amazoncognito.com", prefix, region)
+	case endpoints.AwsUsGovPartitionID:
+		// AWS GovCloud (US) prefix domains are only served by FIPS endpoints.
+		host = fmt.Sprintf("%s.auth-fips.%s.amazoncognito.com", prefix, region)
+	default:
+		return "", fmt.Errorf("user pool prefix domains are not supported in partition %q", partition)
+	}
+
+	return fmt.Sprintf("https://%s/oauth2/token", host), nil
+}
+
+// refreshTokenAuthUsernameRequiredDiag returns the error diagnostic for a missing username with REFRESH_TOKEN_AUTH.
+// The SECRET_HASH authentication parameter is computed from the username, so refreshing tokens for an app client with a secret requires it.
+func refreshTokenAuthUsernameRequiredDiag() diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		path.Root(names.AttrUsername),
+		"Missing Required Attribute",
+		fmt.Sprintf("%q is required when auth_flow is %q and the app client has a secret.", names.AttrUsername, awstypes.AuthFlowTypeRefreshTokenAuth),
+	)
+}
+
+// userPoolSecretHash returns the SECRET_HASH authentication parameter required for app clients with a client secret.
+// See https://docs.aws.amazon.com/cognito/latest/developerguide/signing-up-users-in-your-app.html#cognito-user-pools-computing-secret-hash.
+func userPoolSecretHash(username, clientID, clientSecret string) string {
+	mac := hmac.New(sha256.New, []byte(clientSecret))
+	mac.Write([]byte(username + clientID))
+
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
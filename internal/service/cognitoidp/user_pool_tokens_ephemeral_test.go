@@ -0,0 +1,245 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitoidp_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfcognitoidp "github.com/hashicorp/terraform-provider-aws/internal/service/cognitoidp"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestUserPoolSecretHash(t *testing.T) {
+	t.Parallel()
+
+	// echo -n "user1clientid" | openssl dgst -sha256 -hmac "clientsecret" -binary | base64
+	if got, want := tfcognitoidp.UserPoolSecretHash("user1", "clientid", "clientsecret"), "BUpIJvBLdyQIkljIp1gmBMBjnE9bAsUPb0yvgpTede8="; got != want {
+		t.Errorf("UserPoolSecretHash() = %q, want %q", got, want)
+	}
+}
+
+func TestUserPoolTokenEndpoint(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		userPool    awstypes.UserPoolType
+		partition   string
+		region      string
+		expected    string
+		expectedErr bool
+	}{
+		"prefix domain": {
+			userPool:  awstypes.UserPoolType{Domain: aws.String("example")},
+			partition: endpoints.AwsPartitionID,
+			region:    endpoints.UsWest2RegionID,
+			expected:  "https://example.auth.us-west-2.amazoncognito.com/oauth2/token",
+		},
+		"custom domain": {
+			userPool:  awstypes.UserPoolType{CustomDomain: aws.String("auth.example.com"), Domain: aws.String("example")},
+			partition: endpoints.AwsPartitionID,
+			region:    endpoints.UsWest2RegionID,
+			expected:  "https://auth.example.com/oauth2/token",
+		},
+		"GovCloud prefix domain": {
+			userPool:  awstypes.UserPoolType{Domain: aws.String("example")},
+			partition: endpoints.AwsUsGovPartitionID,
+			region:    endpoints.UsGovWest1RegionID,
+			expected:  "https://example.auth-fips.us-gov-west-1.amazoncognito.com/oauth2/token",
+		},
+		"GovCloud custom domain": {
+			userPool:  awstypes.UserPoolType{CustomDomain: aws.String("auth.example.com")},
+			partition: endpoints.AwsUsGovPartitionID,
+			region:    endpoints.UsGovWest1RegionID,
+			expected:  "https://auth.example.com/oauth2/token",
+		},
+		"unsupported partition": {
+			userPool:    awstypes.UserPoolType{Domain: aws.String("example")},
+			partition:   endpoints.AwsIsoPartitionID,
+			region:      endpoints.UsIsoEast1RegionID,
+			expectedErr: true,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfcognitoidp.UserPoolTokenEndpoint(&testcase.userPool, testcase.partition, testcase.region)
+
+			if got, want := err != nil, testcase.expectedErr; got != want {
+				t.Fatalf("UserPoolTokenEndpoint() err = %v, want error %t", err, want)
+			}
+			if got != testcase.expected {
+				t.Errorf("UserPoolTokenEndpoint() = %q, want %q", got, testcase.expected)
+			}
+		})
+	}
+}
+
+func TestAccCognitoIDPUserPoolTokensEphemeral_adminUserPassword(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t); testAccPreCheckIdentityProvider(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.CognitoIDPServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserPoolTokensEphemeralResourceConfig_adminUserPassword(rName, false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("access_token"), knownvalue.StringRegexp(regexache.MustCompile(`^[\w-]+\.[\w-]+\.[\w-]+$`))),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("id_token"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("refresh_token"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("token_type"), knownvalue.StringExact("Bearer")),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("expires_in"), knownvalue.Int64Exact(3600)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccCognitoIDPUserPoolTokensEphemeral_clientSecret(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t); testAccPreCheckIdentityProvider(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.CognitoIDPServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserPoolTokensEphemeralResourceConfig_adminUserPassword(rName, true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("access_token"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("id_token"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccCognitoIDPUserPoolTokensEphemeral_clientCredentials(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t); testAccPreCheckIdentityProvider(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.CognitoIDPServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserPoolTokensEphemeralResourceConfig_clientCredentials(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("access_token"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("id_token"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("token_type"), knownvalue.StringExact("Bearer")),
+				},
+			},
+		},
+	})
+}
+
+func testAccUserPoolTokensEphemeralResourceConfig_adminUserPassword(rName string, generateSecret bool) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_cognito_user_pool_tokens.test"),
+		fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+}
+
+resource "aws_cognito_user_pool_client" "test" {
+  name                = %[1]q
+  user_pool_id        = aws_cognito_user_pool.test.id
+  generate_secret     = %[2]t
+  explicit_auth_flows = ["ALLOW_ADMIN_USER_PASSWORD_AUTH", "ALLOW_REFRESH_TOKEN_AUTH"]
+}
+
+resource "aws_cognito_user" "test" {
+  user_pool_id = aws_cognito_user_pool.test.id
+  username     = %[1]q
+  password     = "Password1!"
+}
+
+ephemeral "aws_cognito_user_pool_tokens" "test" {
+  auth_flow    = "ADMIN_USER_PASSWORD_AUTH"
+  user_pool_id = aws_cognito_user_pool.test.id
+  client_id    = aws_cognito_user_pool_client.test.id
+  username     = aws_cognito_user.test.username
+  password     = "Password1!"
+}
+`, rName, generateSecret))
+}
+
+func testAccUserPoolTokensEphemeralResourceConfig_clientCredentials(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_cognito_user_pool_tokens.test"),
+		fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+}
+
+resource "aws_cognito_user_pool_domain" "test" {
+  domain       = %[1]q
+  user_pool_id = aws_cognito_user_pool.test.id
+}
+
+resource "aws_cognito_resource_server" "test" {
+  identifier   = "https://example.com"
+  name         = %[1]q
+  user_pool_id = aws_cognito_user_pool.test.id
+
+  scope {
+    scope_name        = "read"
+    scope_description = "Read access"
+  }
+}
+
+resource "aws_cognito_user_pool_client" "test" {
+  name                                 = %[1]q
+  user_pool_id                         = aws_cognito_user_pool.test.id
+  generate_secret                      = true
+  allowed_oauth_flows                  = ["client_credentials"]
+  allowed_oauth_flows_user_pool_client = true
+  allowed_oauth_scopes                 = aws_cognito_resource_server.test.scope_identifiers
+}
+
+ephemeral "aws_cognito_user_pool_tokens" "test" {
+  auth_flow    = "CLIENT_CREDENTIALS"
+  user_pool_id = aws_cognito_user_pool.test.id
+  client_id    = aws_cognito_user_pool_client.test.id
+
+  depends_on = [aws_cognito_user_pool_domain.test]
+}
+`, rName))
+}
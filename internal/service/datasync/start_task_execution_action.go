@@ -0,0 +1,198 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package datasync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datasync"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datasync/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_datasync_start_task_execution, name="Start Task Execution")
+func newStartTaskExecutionAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startTaskExecutionAction{}, nil
+}
+
+var (
+	_ action.Action = (*startTaskExecutionAction)(nil)
+)
+
+type startTaskExecutionAction struct {
+	framework.ActionWithModel[startTaskExecutionActionModel]
+}
+
+type startTaskExecutionActionModel struct {
+	framework.WithRegionModel
+	TaskARN fwtypes.ARN `tfsdk:"task_arn"`
+	Timeout types.Int64 `tfsdk:"timeout"`
+}
+
+func (a *startTaskExecutionAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts a DataSync task execution and waits for the transfer to complete.",
+		Attributes: map[string]schema.Attribute{
+			"task_arn": schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "The ARN of the DataSync task to start",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in minutes to wait for the task execution to complete (default: 60)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AtMost(2880),
+				},
+			},
+		},
+	}
+}
+
+func (a *startTaskExecutionAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startTaskExecutionActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().DataSyncClient(ctx)
+
+	taskARN := config.TaskARN.ValueString()
+
+	timeout := 60 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Minute
+	}
+
+	tflog.Info(ctx, "Starting DataSync start task execution action", map[string]any{
+		"task_arn":        taskARN,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting execution of DataSync task %s...", taskARN),
+	})
+
+	if _, err := findTaskByARN(ctx, conn, taskARN); err != nil {
+		if retry.NotFound(err) {
+			resp.Diagnostics.AddError(
+				"Task Not Found",
+				fmt.Sprintf("DataSync task %s was not found", taskARN),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to Describe Task",
+			fmt.Sprintf("Could not describe DataSync task %s: %s", taskARN, err),
+		)
+		return
+	}
+
+	input := datasync.StartTaskExecutionInput{
+		TaskArn: aws.String(taskARN),
+	}
+
+	output, err := conn.StartTaskExecution(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start Task Execution",
+			fmt.Sprintf("Could not start execution of DataSync task %s: %s", taskARN, err),
+		)
+		return
+	}
+
+	executionARN := aws.ToString(output.TaskExecutionArn)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("DataSync task execution %s started, waiting for completion...", executionARN),
+	})
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*datasync.DescribeTaskExecutionOutput], error) {
+		input := datasync.DescribeTaskExecutionInput{
+			TaskExecutionArn: aws.String(executionARN),
+		}
+		output, derr := conn.DescribeTaskExecution(ctx, &input)
+		if derr != nil {
+			return actionwait.FetchResult[*datasync.DescribeTaskExecutionOutput]{}, derr
+		}
+		return actionwait.FetchResult[*datasync.DescribeTaskExecutionOutput]{Status: actionwait.Status(output.Status), Value: output}, nil
+	}, actionwait.Options[*datasync.DescribeTaskExecutionOutput]{
+		Timeout:          timeout,
+		Interval:         actionwait.WithBackoffDelay(backoff.DefaultSDKv2HelperRetryCompatibleDelay()),
+		ProgressInterval: time.Minute,
+		SuccessStates:    []actionwait.Status{actionwait.Status(awstypes.TaskExecutionStatusSuccess)},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.TaskExecutionStatusQueued),
+			actionwait.Status(awstypes.TaskExecutionStatusLaunching),
+			actionwait.Status(awstypes.TaskExecutionStatusPreparing),
+			actionwait.Status(awstypes.TaskExecutionStatusTransferring),
+			actionwait.Status(awstypes.TaskExecutionStatusVerifying),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.TaskExecutionStatusError),
+			actionwait.Status(awstypes.TaskExecutionStatusCancelling),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("DataSync task execution %s is currently in state '%s'", executionARN, fr.Status)})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Task Execution",
+				fmt.Sprintf("DataSync task execution %s did not complete within %s: %s", executionARN, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			message := err.Error()
+			if result.Value != nil && result.Value.Result != nil && result.Value.Result.ErrorCode != nil {
+				message = fmt.Sprintf("%s: %s: %s", message, aws.ToString(result.Value.Result.ErrorCode), aws.ToString(result.Value.Result.ErrorDetail))
+			}
+			resp.Diagnostics.AddError(
+				"Task Execution Failed",
+				fmt.Sprintf("DataSync task execution %s failed: %s", executionARN, message),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Task Execution State",
+				fmt.Sprintf("DataSync task execution %s entered unexpected state: %s", executionARN, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Task Execution",
+				fmt.Sprintf("Error while waiting for DataSync task execution %s: %s", executionARN, err),
+			)
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("DataSync task execution %s completed successfully, %d files transferred", executionARN, result.Value.FilesTransferred),
+	})
+
+	tflog.Info(ctx, "DataSync start task execution action completed successfully", map[string]any{
+		"task_arn":           taskARN,
+		"task_execution_arn": executionARN,
+	})
+}
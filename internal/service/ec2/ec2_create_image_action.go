@@ -0,0 +1,254 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// createImagePollInterval defines polling cadence for create image action.
+const createImagePollInterval = 15 * time.Second
+
+// @Action(aws_ec2_create_image, name="Create Image")
+func newCreateImageAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &createImageAction{}, nil
+}
+
+var (
+	_ action.Action = (*createImageAction)(nil)
+)
+
+type createImageAction struct {
+	framework.ActionWithModel[createImageModel]
+}
+
+type createImageModel struct {
+	framework.WithRegionModel
+	InstanceID  types.String        `tfsdk:"instance_id"`
+	Name        types.String        `tfsdk:"name"`
+	Description types.String        `tfsdk:"description"`
+	NoReboot    types.Bool          `tfsdk:"no_reboot"`
+	Tags        fwtypes.MapOfString `tfsdk:"tags"`
+	Timeout     types.Int64         `tfsdk:"timeout"`
+}
+
+func (a *createImageAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates an Amazon EBS-backed AMI from an EC2 instance. This action will create the image and wait for it to reach the available state.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrInstanceID: schema.StringAttribute{
+				Description: "The ID of the EC2 instance to create the image from",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexache.MustCompile(`^i-[0-9a-f]{8,17}$`),
+						"must be a valid EC2 instance ID (e.g., i-1234567890abcdef0)",
+					),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Description: "A name for the new image",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 128),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Description: "A description for the new image",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			"no_reboot": schema.BoolAttribute{
+				Description: "Whether to create the image without shutting down and rebooting the instance. File system integrity on the created image can't be guaranteed if set to true",
+				Optional:    true,
+			},
+			names.AttrTags: schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Description: "Tags to apply to the image and its snapshots",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the image to become available (default: 2400)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(14400),
+				},
+			},
+		},
+	}
+}
+
+func (a *createImageAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config createImageModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().EC2Client(ctx)
+
+	instanceID := config.InstanceID.ValueString()
+	name := config.Name.ValueString()
+
+	// Set default timeout if not provided
+	timeout := 2400 * time.Second
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting EC2 create image action", map[string]any{
+		names.AttrInstanceID: instanceID,
+		names.AttrName:       name,
+		"no_reboot":          config.NoReboot.ValueBool(),
+		names.AttrTimeout:    timeout.String(),
+	})
+
+	// Send initial progress update
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting image creation from EC2 instance %s...", instanceID),
+	})
+
+	// Verify the instance exists before requesting the image
+	if _, err := findInstanceByID(ctx, conn, instanceID); err != nil {
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidInstanceIDNotFound) {
+			resp.Diagnostics.AddError(
+				"Instance Not Found",
+				fmt.Sprintf("EC2 instance %s was not found", instanceID),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to Describe Instance",
+			fmt.Sprintf("Could not describe EC2 instance %s: %s", instanceID, err),
+		)
+		return
+	}
+
+	input := ec2.CreateImageInput{
+		Description: config.Description.ValueStringPointer(),
+		InstanceId:  aws.String(instanceID),
+		Name:        aws.String(name),
+		NoReboot:    config.NoReboot.ValueBoolPointer(),
+	}
+
+	if tags := tftags.New(ctx, fwflex.ExpandFrameworkStringMap(ctx, config.Tags)); len(tags) > 0 {
+		input.TagSpecifications = append(
+			tagSpecificationsFromKeyValue(tags, string(awstypes.ResourceTypeImage)),
+			tagSpecificationsFromKeyValue(tags, string(awstypes.ResourceTypeSnapshot))...,
+		)
+	}
+
+	output, err := conn.CreateImage(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Create Image",
+			fmt.Sprintf("Could not create image from EC2 instance %s: %s", instanceID, err),
+		)
+		return
+	}
+
+	imageID := aws.ToString(output.ImageId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Image %s is being created from EC2 instance %s, waiting for it to become available...", imageID, instanceID),
+	})
+
+	// Wait for the image to become available. A newly created image may not be
+	// visible immediately, so treat not found as pending.
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.Image], error) {
+		image, derr := findImageByID(ctx, conn, imageID)
+		if retry.NotFound(derr) {
+			return actionwait.FetchResult[*awstypes.Image]{Status: actionwait.Status(awstypes.ImageStatePending)}, nil
+		}
+		if derr != nil {
+			return actionwait.FetchResult[*awstypes.Image]{}, fmt.Errorf("describing image: %w", derr)
+		}
+		return actionwait.FetchResult[*awstypes.Image]{Status: actionwait.Status(image.State), Value: image}, nil
+	}, actionwait.Options[*awstypes.Image]{
+		Timeout:            timeout,
+		Interval:           actionwait.FixedInterval(createImagePollInterval),
+		ProgressInterval:   time.Minute,
+		SuccessStates:      []actionwait.Status{actionwait.Status(awstypes.ImageStateAvailable)},
+		TransitionalStates: []actionwait.Status{actionwait.Status(awstypes.ImageStatePending)},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.ImageStateFailed),
+			actionwait.Status(awstypes.ImageStateError),
+			actionwait.Status(awstypes.ImageStateInvalid),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Image %s is currently in state '%s', continuing to wait for 'available'...", imageID, fr.Status)})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Image",
+				fmt.Sprintf("Image %s did not become available within %s: %s", imageID, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			message := err.Error()
+			if result.Value != nil && result.Value.StateReason != nil {
+				message = fmt.Sprintf("%s: %s", message, aws.ToString(result.Value.StateReason.Message))
+			}
+			resp.Diagnostics.AddError(
+				"Image Creation Failed",
+				fmt.Sprintf("Image %s from EC2 instance %s failed: %s", imageID, instanceID, message),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Image State",
+				fmt.Sprintf("Image %s entered unexpected state while being created: %s", imageID, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Image",
+				fmt.Sprintf("Error while waiting for image %s to become available: %s", imageID, err),
+			)
+		}
+		return
+	}
+
+	// Final success message
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Image %s has been successfully created from EC2 instance %s", imageID, instanceID),
+	})
+
+	tflog.Info(ctx, "EC2 create image action completed successfully", map[string]any{
+		names.AttrInstanceID: instanceID,
+		"image_id":           imageID,
+	})
+}
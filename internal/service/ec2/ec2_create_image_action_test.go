@@ -0,0 +1,152 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2CreateImageAction_trigger(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.EC2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckCreateImageActionDestroy(ctx, rName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCreateImageActionConfig_trigger(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCreateImageActionImageAvailable(ctx, rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCreateImageActionImageAvailable(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		images, err := findCreateImageActionImages(ctx, conn, name)
+		if err != nil {
+			return err
+		}
+
+		if len(images) != 1 {
+			return fmt.Errorf("Expected 1 image named %s, got %d", name, len(images))
+		}
+
+		if images[0].State != awstypes.ImageStateAvailable {
+			return fmt.Errorf("Expected image state %s, got %s", awstypes.ImageStateAvailable, images[0].State)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckCreateImageActionDestroy deregisters any images created by the action,
+// as they are not managed by Terraform.
+func testAccCheckCreateImageActionDestroy(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		images, err := findCreateImageActionImages(ctx, conn, name)
+		if err != nil {
+			return err
+		}
+
+		for _, image := range images {
+			input := ec2.DeregisterImageInput{
+				DeleteAssociatedSnapshots: aws.Bool(true),
+				ImageId:                   image.ImageId,
+			}
+			if _, err := conn.DeregisterImage(ctx, &input); err != nil {
+				return fmt.Errorf("deregistering image %s: %w", aws.ToString(image.ImageId), err)
+			}
+		}
+
+		return nil
+	}
+}
+
+func findCreateImageActionImages(ctx context.Context, conn *ec2.Client, name string) ([]awstypes.Image, error) {
+	input := ec2.DescribeImagesInput{
+		Filters: []awstypes.Filter{
+			{
+				Name:   aws.String(names.AttrName),
+				Values: []string{name},
+			},
+		},
+		Owners: []string{"self"},
+	}
+
+	output, err := conn.DescribeImages(ctx, &input)
+	if err != nil {
+		return nil, err
+	}
+
+	return output.Images, nil
+}
+
+func testAccCreateImageActionConfig_trigger(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		acctest.ConfigAvailableAZsNoOptIn(),
+		acctest.AvailableEC2InstanceTypeForAvailabilityZone("data.aws_availability_zones.available.names[0]", "t3.micro", "t2.micro"),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+action "aws_ec2_create_image" "test" {
+  config {
+    instance_id = aws_instance.test.id
+    name        = %[1]q
+    no_reboot   = true
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.aws_ec2_create_image.test]
+    }
+  }
+
+  depends_on = [aws_instance.test]
+}
+`, rName))
+}
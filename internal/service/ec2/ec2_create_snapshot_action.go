@@ -0,0 +1,232 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// createSnapshotPollInterval defines polling cadence for create snapshot action.
+const createSnapshotPollInterval = 15 * time.Second
+
+// @Action(aws_ec2_create_snapshot, name="Create Snapshot")
+func newCreateSnapshotAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &createSnapshotAction{}, nil
+}
+
+var (
+	_ action.Action = (*createSnapshotAction)(nil)
+)
+
+type createSnapshotAction struct {
+	framework.ActionWithModel[createSnapshotModel]
+}
+
+type createSnapshotModel struct {
+	framework.WithRegionModel
+	VolumeID    types.String        `tfsdk:"volume_id"`
+	Description types.String        `tfsdk:"description"`
+	Tags        fwtypes.MapOfString `tfsdk:"tags"`
+	Timeout     types.Int64         `tfsdk:"timeout"`
+}
+
+func (a *createSnapshotAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a snapshot of an EBS volume. This action will create the snapshot and wait for it to reach the completed state.",
+		Attributes: map[string]schema.Attribute{
+			"volume_id": schema.StringAttribute{
+				Description: "The ID of the EBS volume to snapshot",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexache.MustCompile(`^vol-[0-9a-f]{8,17}$`),
+						"must be a valid EBS volume ID (e.g., vol-1234567890abcdef0)",
+					),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Description: "A description for the snapshot",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			names.AttrTags: schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Description: "Tags to apply to the snapshot",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the snapshot to complete (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(14400),
+				},
+			},
+		},
+	}
+}
+
+func (a *createSnapshotAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config createSnapshotModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().EC2Client(ctx)
+
+	volumeID := config.VolumeID.ValueString()
+
+	// Set default timeout if not provided
+	timeout := 1800 * time.Second
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting EC2 create snapshot action", map[string]any{
+		"volume_id":       volumeID,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	// Send initial progress update
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting snapshot creation for EBS volume %s...", volumeID),
+	})
+
+	// Verify the volume exists before requesting the snapshot
+	if _, err := findEBSVolumeByID(ctx, conn, volumeID); err != nil {
+		if retry.NotFound(err) {
+			resp.Diagnostics.AddError(
+				"Volume Not Found",
+				fmt.Sprintf("EBS volume %s was not found", volumeID),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to Describe Volume",
+			fmt.Sprintf("Could not describe EBS volume %s: %s", volumeID, err),
+		)
+		return
+	}
+
+	input := ec2.CreateSnapshotInput{
+		Description: config.Description.ValueStringPointer(),
+		VolumeId:    aws.String(volumeID),
+	}
+
+	if tags := tftags.New(ctx, fwflex.ExpandFrameworkStringMap(ctx, config.Tags)); len(tags) > 0 {
+		input.TagSpecifications = tagSpecificationsFromKeyValue(tags, string(awstypes.ResourceTypeSnapshot))
+	}
+
+	output, err := conn.CreateSnapshot(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Create Snapshot",
+			fmt.Sprintf("Could not create snapshot of EBS volume %s: %s", volumeID, err),
+		)
+		return
+	}
+
+	snapshotID := aws.ToString(output.SnapshotId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Snapshot %s of EBS volume %s is being created, waiting for it to complete...", snapshotID, volumeID),
+	})
+
+	// Wait for the snapshot to complete. A newly created snapshot may not be
+	// visible immediately, so treat not found as pending.
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.Snapshot], error) {
+		snapshot, derr := findSnapshotByID(ctx, conn, snapshotID)
+		if retry.NotFound(derr) {
+			return actionwait.FetchResult[*awstypes.Snapshot]{Status: actionwait.Status(awstypes.SnapshotStatePending)}, nil
+		}
+		if derr != nil {
+			return actionwait.FetchResult[*awstypes.Snapshot]{}, fmt.Errorf("describing snapshot: %w", derr)
+		}
+		return actionwait.FetchResult[*awstypes.Snapshot]{Status: actionwait.Status(snapshot.State), Value: snapshot}, nil
+	}, actionwait.Options[*awstypes.Snapshot]{
+		Timeout:            timeout,
+		Interval:           actionwait.FixedInterval(createSnapshotPollInterval),
+		ProgressInterval:   time.Minute,
+		SuccessStates:      []actionwait.Status{actionwait.Status(awstypes.SnapshotStateCompleted)},
+		TransitionalStates: []actionwait.Status{actionwait.Status(awstypes.SnapshotStatePending)},
+		FailureStates:      []actionwait.Status{actionwait.Status(awstypes.SnapshotStateError)},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			progress := ""
+			if snapshot, ok := fr.Value.(*awstypes.Snapshot); ok && snapshot != nil {
+				progress = fmt.Sprintf(" (%s)", aws.ToString(snapshot.Progress))
+			}
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Snapshot %s is currently in state '%s'%s, continuing to wait for 'completed'...", snapshotID, fr.Status, progress)})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Snapshot",
+				fmt.Sprintf("Snapshot %s did not complete within %s: %s", snapshotID, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			message := err.Error()
+			if result.Value != nil && result.Value.StateMessage != nil {
+				message = fmt.Sprintf("%s: %s", message, aws.ToString(result.Value.StateMessage))
+			}
+			resp.Diagnostics.AddError(
+				"Snapshot Creation Failed",
+				fmt.Sprintf("Snapshot %s of EBS volume %s failed: %s", snapshotID, volumeID, message),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Snapshot State",
+				fmt.Sprintf("Snapshot %s entered unexpected state while being created: %s", snapshotID, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Snapshot",
+				fmt.Sprintf("Error while waiting for snapshot %s to complete: %s", snapshotID, err),
+			)
+		}
+		return
+	}
+
+	// Final success message
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Snapshot %s of EBS volume %s has been successfully created", snapshotID, volumeID),
+	})
+
+	tflog.Info(ctx, "EC2 create snapshot action completed successfully", map[string]any{
+		"volume_id":   volumeID,
+		"snapshot_id": snapshotID,
+	})
+}
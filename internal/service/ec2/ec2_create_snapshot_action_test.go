@@ -0,0 +1,148 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2CreateSnapshotAction_trigger(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.EC2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckCreateSnapshotActionDestroy(ctx, rName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCreateSnapshotActionConfig_trigger(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCreateSnapshotActionSnapshotCompleted(ctx, rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCreateSnapshotActionSnapshotCompleted(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		snapshots, err := findCreateSnapshotActionSnapshots(ctx, conn, name)
+		if err != nil {
+			return err
+		}
+
+		if len(snapshots) != 1 {
+			return fmt.Errorf("Expected 1 snapshot tagged %s, got %d", name, len(snapshots))
+		}
+
+		if snapshots[0].State != awstypes.SnapshotStateCompleted {
+			return fmt.Errorf("Expected snapshot state %s, got %s", awstypes.SnapshotStateCompleted, snapshots[0].State)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckCreateSnapshotActionDestroy deletes any snapshots created by the action,
+// as they are not managed by Terraform.
+func testAccCheckCreateSnapshotActionDestroy(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		snapshots, err := findCreateSnapshotActionSnapshots(ctx, conn, name)
+		if err != nil {
+			return err
+		}
+
+		for _, snapshot := range snapshots {
+			input := ec2.DeleteSnapshotInput{
+				SnapshotId: snapshot.SnapshotId,
+			}
+			if _, err := conn.DeleteSnapshot(ctx, &input); err != nil {
+				return fmt.Errorf("deleting snapshot %s: %w", aws.ToString(snapshot.SnapshotId), err)
+			}
+		}
+
+		return nil
+	}
+}
+
+func findCreateSnapshotActionSnapshots(ctx context.Context, conn *ec2.Client, name string) ([]awstypes.Snapshot, error) {
+	input := ec2.DescribeSnapshotsInput{
+		Filters: []awstypes.Filter{
+			{
+				Name:   aws.String("tag:Name"),
+				Values: []string{name},
+			},
+		},
+		OwnerIds: []string{"self"},
+	}
+
+	output, err := conn.DescribeSnapshots(ctx, &input)
+	if err != nil {
+		return nil, err
+	}
+
+	return output.Snapshots, nil
+}
+
+func testAccCreateSnapshotActionConfig_trigger(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAvailableAZsNoOptIn(),
+		fmt.Sprintf(`
+resource "aws_ebs_volume" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  size              = 1
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+action "aws_ec2_create_snapshot" "test" {
+  config {
+    volume_id   = aws_ebs_volume.test.id
+    description = %[1]q
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.aws_ec2_create_snapshot.test]
+    }
+  }
+
+  depends_on = [aws_ebs_volume.test]
+}
+`, rName))
+}
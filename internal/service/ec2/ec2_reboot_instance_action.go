@@ -0,0 +1,165 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_ec2_reboot_instance, name="Reboot Instance")
+func newRebootInstanceAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &rebootInstanceAction{}, nil
+}
+
+var (
+	_ action.Action = (*rebootInstanceAction)(nil)
+)
+
+type rebootInstanceAction struct {
+	framework.ActionWithModel[rebootInstanceModel]
+}
+
+type rebootInstanceModel struct {
+	framework.WithRegionModel
+	InstanceID types.String `tfsdk:"instance_id"`
+	Timeout    types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *rebootInstanceAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reboots a running EC2 instance. This action only requests the reboot; it does not wait for the instance to restart.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrInstanceID: schema.StringAttribute{
+				Description: "The ID of the EC2 instance to reboot",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexache.MustCompile(`^i-[0-9a-f]{8,17}$`),
+						"must be a valid EC2 instance ID (e.g., i-1234567890abcdef0)",
+					),
+				},
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the reboot request to complete (default: 600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(30),
+					int64validator.AtMost(3600),
+				},
+			},
+		},
+	}
+}
+
+func (a *rebootInstanceAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config rebootInstanceModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().EC2Client(ctx)
+
+	instanceID := config.InstanceID.ValueString()
+
+	// Set default timeout if not provided
+	timeout := 600 * time.Second
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting EC2 reboot instance action", map[string]any{
+		names.AttrInstanceID: instanceID,
+		names.AttrTimeout:    timeout.String(),
+	})
+
+	// RebootInstances is asynchronous, so the timeout bounds the requests rather than the reboot itself
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Send initial progress update
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting reboot operation for EC2 instance %s...", instanceID),
+	})
+
+	// Check current instance state first
+	instance, err := findInstanceByID(ctx, conn, instanceID)
+	if err != nil {
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidInstanceIDNotFound) {
+			resp.Diagnostics.AddError(
+				"Instance Not Found",
+				fmt.Sprintf("EC2 instance %s was not found", instanceID),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to Describe Instance",
+			fmt.Sprintf("Could not describe EC2 instance %s: %s", instanceID, err),
+		)
+		return
+	}
+
+	// Only running instances can be rebooted
+	if instance.State.Name != awstypes.InstanceStateNameRunning {
+		resp.Diagnostics.AddError(
+			"Cannot Reboot Instance",
+			fmt.Sprintf("EC2 instance %s is in state '%s' and cannot be rebooted. Instance must be in 'running' state.", instanceID, instance.State.Name),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Sending reboot command to EC2 instance %s...", instanceID),
+	})
+
+	input := ec2.RebootInstancesInput{
+		InstanceIds: []string{instanceID},
+	}
+
+	_, err = conn.RebootInstances(ctx, &input)
+	if errors.Is(err, context.DeadlineExceeded) {
+		resp.Diagnostics.AddError(
+			"Timeout Rebooting Instance",
+			fmt.Sprintf("EC2 instance %s reboot request did not complete within %s: %s", instanceID, timeout, err),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Reboot Instance",
+			fmt.Sprintf("Could not reboot EC2 instance %s: %s", instanceID, err),
+		)
+		return
+	}
+
+	// RebootInstances is asynchronous and a reboot doesn't change the instance state,
+	// nor is there any other signal that the reboot has taken place, so don't wait.
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Reboot command sent to EC2 instance %s", instanceID),
+	})
+
+	tflog.Info(ctx, "EC2 reboot instance action completed successfully", map[string]any{
+		names.AttrInstanceID: instanceID,
+	})
+}
@@ -0,0 +1,239 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// startInstancePollInterval defines polling cadence for start instance action.
+const startInstancePollInterval = 10 * time.Second
+
+// @Action(aws_ec2_start_instance, name="Start Instance")
+func newStartInstanceAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startInstanceAction{}, nil
+}
+
+var (
+	_ action.Action = (*startInstanceAction)(nil)
+)
+
+type startInstanceAction struct {
+	framework.ActionWithModel[startInstanceModel]
+}
+
+type startInstanceModel struct {
+	framework.WithRegionModel
+	InstanceID types.String `tfsdk:"instance_id"`
+	Timeout    types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *startInstanceAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts a stopped EC2 instance. This action will start the instance and wait for it to reach the running state.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrInstanceID: schema.StringAttribute{
+				Description: "The ID of the EC2 instance to start",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexache.MustCompile(`^i-[0-9a-f]{8,17}$`),
+						"must be a valid EC2 instance ID (e.g., i-1234567890abcdef0)",
+					),
+				},
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the instance to start (default: 600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(30),
+					int64validator.AtMost(3600),
+				},
+			},
+		},
+	}
+}
+
+func (a *startInstanceAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startInstanceModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().EC2Client(ctx)
+
+	instanceID := config.InstanceID.ValueString()
+
+	// Set default timeout if not provided
+	timeout := 600 * time.Second
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting EC2 start instance action", map[string]any{
+		names.AttrInstanceID: instanceID,
+		names.AttrTimeout:    timeout.String(),
+	})
+
+	// Send initial progress update
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting start operation for EC2 instance %s...", instanceID),
+	})
+
+	// Check current instance state first
+	instance, err := findInstanceByID(ctx, conn, instanceID)
+	if err != nil {
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidInstanceIDNotFound) {
+			resp.Diagnostics.AddError(
+				"Instance Not Found",
+				fmt.Sprintf("EC2 instance %s was not found", instanceID),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to Describe Instance",
+			fmt.Sprintf("Could not describe EC2 instance %s: %s", instanceID, err),
+		)
+		return
+	}
+
+	currentState := string(instance.State.Name)
+	tflog.Debug(ctx, "Current instance state", map[string]any{
+		names.AttrInstanceID: instanceID,
+		names.AttrState:      currentState,
+	})
+
+	// Check if instance is already running
+	if instance.State.Name == awstypes.InstanceStateNameRunning {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("EC2 instance %s is already running", instanceID),
+		})
+		tflog.Info(ctx, "Instance already running", map[string]any{
+			names.AttrInstanceID: instanceID,
+		})
+		return
+	}
+
+	// Check if instance is in a state that can be started
+	if !canStartInstance(instance.State.Name) {
+		resp.Diagnostics.AddError(
+			"Cannot Start Instance",
+			fmt.Sprintf("EC2 instance %s is in state '%s' and cannot be started. Instance must be in 'stopped' or 'pending' state.", instanceID, currentState),
+		)
+		return
+	}
+
+	// If instance is already pending, just wait for it
+	if instance.State.Name == awstypes.InstanceStateNamePending {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("EC2 instance %s is already starting, waiting for completion...", instanceID),
+		})
+	} else {
+		// Start the instance
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Sending start command to EC2 instance %s...", instanceID),
+		})
+
+		input := ec2.StartInstancesInput{
+			InstanceIds: []string{instanceID},
+		}
+
+		_, err = conn.StartInstances(ctx, &input)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Start Instance",
+				fmt.Sprintf("Could not start EC2 instance %s: %s", instanceID, err),
+			)
+			return
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Start command sent to EC2 instance %s, waiting for instance to start...", instanceID),
+		})
+	}
+
+	// Wait for instance to start with periodic progress updates using actionwait
+	_, err = actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[struct{}], error) {
+		instance, derr := findInstanceByID(ctx, conn, instanceID)
+		if derr != nil {
+			return actionwait.FetchResult[struct{}]{}, fmt.Errorf("describing instance: %w", derr)
+		}
+		state := string(instance.State.Name)
+		return actionwait.FetchResult[struct{}]{Status: actionwait.Status(state)}, nil
+	}, actionwait.Options[struct{}]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(startInstancePollInterval),
+		ProgressInterval: 30 * time.Second,
+		SuccessStates:    []actionwait.Status{actionwait.Status(awstypes.InstanceStateNameRunning)},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.InstanceStateNameStopped),
+			actionwait.Status(awstypes.InstanceStateNamePending),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("EC2 instance %s is currently in state '%s', continuing to wait for 'running'...", instanceID, fr.Status)})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Instance to Start",
+				fmt.Sprintf("EC2 instance %s did not start within %s: %s", instanceID, timeout, err),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Instance State",
+				fmt.Sprintf("EC2 instance %s entered unexpected state while starting: %s", instanceID, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Instance to Start",
+				fmt.Sprintf("Error while waiting for EC2 instance %s to start: %s", instanceID, err),
+			)
+		}
+		return
+	}
+
+	// Final success message
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("EC2 instance %s has been successfully started", instanceID),
+	})
+
+	tflog.Info(ctx, "EC2 start instance action completed successfully", map[string]any{
+		names.AttrInstanceID: instanceID,
+	})
+}
+
+// canStartInstance checks if an instance can be started based on its current state
+func canStartInstance(state awstypes.InstanceStateName) bool {
+	switch state {
+	case awstypes.InstanceStateNameStopped, awstypes.InstanceStateNamePending:
+		return true
+	default:
+		return false
+	}
+}
@@ -24,6 +24,30 @@ type servicePackage struct{}
 
 func (p *servicePackage) Actions(ctx context.Context) []*inttypes.ServicePackageAction {
 	return []*inttypes.ServicePackageAction{
+		{
+			Factory:  newCreateImageAction,
+			TypeName: "aws_ec2_create_image",
+			Name:     "Create Image",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  newCreateSnapshotAction,
+			TypeName: "aws_ec2_create_snapshot",
+			Name:     "Create Snapshot",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  newRebootInstanceAction,
+			TypeName: "aws_ec2_reboot_instance",
+			Name:     "Reboot Instance",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  newStartInstanceAction,
+			TypeName: "aws_ec2_start_instance",
+			Name:     "Start Instance",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newStopInstanceAction,
 			TypeName: "aws_ec2_stop_instance",
@@ -0,0 +1,231 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package glue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/glue/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_glue_start_job_run, name="Start Job Run")
+func newStartJobRunAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startJobRunAction{}, nil
+}
+
+var (
+	_ action.Action = (*startJobRunAction)(nil)
+)
+
+type startJobRunAction struct {
+	framework.ActionWithModel[startJobRunActionModel]
+}
+
+type startJobRunActionModel struct {
+	framework.WithRegionModel
+	JobName         types.String                                `tfsdk:"job_name"`
+	Arguments       fwtypes.MapOfString                         `tfsdk:"arguments"`
+	NumberOfWorkers types.Int64                                 `tfsdk:"number_of_workers"`
+	WorkerType      fwtypes.StringEnum[awstypes.WorkerType]     `tfsdk:"worker_type"`
+	ExecutionClass  fwtypes.StringEnum[awstypes.ExecutionClass] `tfsdk:"execution_class"`
+	Timeout         types.Int64                                 `tfsdk:"timeout" autoflex:"-"`
+}
+
+func (a *startJobRunAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts a Glue job run and waits for it to reach a terminal state.",
+		Attributes: map[string]schema.Attribute{
+			"job_name": schema.StringAttribute{
+				Description: "The name of the Glue job to run",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"arguments": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Description: "Job arguments for this run. These replace the default arguments set in the job definition",
+				Optional:    true,
+			},
+			"number_of_workers": schema.Int64Attribute{
+				Description: "Number of workers of the defined worker_type allocated when the job runs",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AlsoRequires(path.MatchRoot("worker_type")),
+				},
+			},
+			"worker_type": schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.WorkerType](),
+				Description: "Type of predefined worker allocated when the job runs",
+				Optional:    true,
+			},
+			"execution_class": schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.ExecutionClass](),
+				Description: "Indicates whether the job is run with a standard or flexible execution class",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in minutes to wait for the job run to complete (default: 60)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AtMost(2880),
+				},
+			},
+		},
+	}
+}
+
+func (a *startJobRunAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startJobRunActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().GlueClient(ctx)
+
+	jobName := config.JobName.ValueString()
+
+	timeout := 60 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Minute
+	}
+
+	tflog.Info(ctx, "Starting Glue start job run action", map[string]any{
+		"job_name":        jobName,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting run of Glue job %s...", jobName),
+	})
+
+	var input glue.StartJobRunInput
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.StartJobRun(ctx, &input)
+	if err != nil {
+		if errs.IsA[*awstypes.EntityNotFoundException](err) {
+			resp.Diagnostics.AddError(
+				"Job Not Found",
+				fmt.Sprintf("Glue job %s was not found", jobName),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to Start Job Run",
+			fmt.Sprintf("Could not start run of Glue job %s: %s", jobName, err),
+		)
+		return
+	}
+
+	runID := aws.ToString(output.JobRunId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Glue job %s run %s started, waiting for completion...", jobName, runID),
+	})
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.JobRun], error) {
+		input := glue.GetJobRunInput{
+			JobName: aws.String(jobName),
+			RunId:   aws.String(runID),
+		}
+		output, gerr := conn.GetJobRun(ctx, &input)
+		if gerr != nil {
+			return actionwait.FetchResult[*awstypes.JobRun]{}, gerr
+		}
+		if output == nil || output.JobRun == nil {
+			return actionwait.FetchResult[*awstypes.JobRun]{}, fmt.Errorf("job run %s not found in GetJobRun response", runID)
+		}
+		return actionwait.FetchResult[*awstypes.JobRun]{Status: actionwait.Status(output.JobRun.JobRunState), Value: output.JobRun}, nil
+	}, actionwait.Options[*awstypes.JobRun]{
+		Timeout:          timeout,
+		Interval:         actionwait.WithBackoffDelay(backoff.DefaultSDKv2HelperRetryCompatibleDelay()),
+		ProgressInterval: time.Minute,
+		SuccessStates:    []actionwait.Status{actionwait.Status(awstypes.JobRunStateSucceeded)},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.JobRunStateStarting),
+			actionwait.Status(awstypes.JobRunStateRunning),
+			actionwait.Status(awstypes.JobRunStateStopping),
+			actionwait.Status(awstypes.JobRunStateWaiting),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.JobRunStateFailed),
+			actionwait.Status(awstypes.JobRunStateError),
+			actionwait.Status(awstypes.JobRunStateTimeout),
+			actionwait.Status(awstypes.JobRunStateStopped),
+			actionwait.Status(awstypes.JobRunStateExpired),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Glue job %s run %s is currently in state '%s'", jobName, runID, fr.Status)})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Job Run",
+				fmt.Sprintf("Glue job %s run %s did not complete within %s: %s", jobName, runID, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			message := err.Error()
+			if result.Value != nil && result.Value.ErrorMessage != nil {
+				message = fmt.Sprintf("%s: %s", message, aws.ToString(result.Value.ErrorMessage))
+			}
+			resp.Diagnostics.AddError(
+				"Job Run Failed",
+				fmt.Sprintf("Glue job %s run %s failed: %s", jobName, runID, message),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Job Run State",
+				fmt.Sprintf("Glue job %s run %s entered unexpected state: %s", jobName, runID, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Job Run",
+				fmt.Sprintf("Error while waiting for Glue job %s run %s: %s", jobName, runID, err),
+			)
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Glue job %s run %s completed successfully in %d seconds", jobName, runID, result.Value.ExecutionTime),
+	})
+
+	tflog.Info(ctx, "Glue start job run action completed successfully", map[string]any{
+		"job_name":   jobName,
+		"job_run_id": runID,
+	})
+}
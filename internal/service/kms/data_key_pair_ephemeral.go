@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameDataKeyPair = "Ephemeral Resource Data Key Pair"
+)
+
+// @EphemeralResource(aws_kms_data_key_pair, name="Data Key Pair")
+func newDataKeyPairEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &dataKeyPairEphemeralResource{}, nil
+}
+
+type dataKeyPairEphemeralResource struct {
+	framework.EphemeralResourceWithModel[dataKeyPairEphemeralResourceModel]
+}
+
+func (e *dataKeyPairEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"context": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"grant_tokens": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"key_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrKeyID: schema.StringAttribute{
+				Required: true,
+			},
+			"key_pair_spec": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DataKeyPairSpec](),
+				Required:   true,
+			},
+			"private_key_ciphertext_blob": schema.StringAttribute{
+				Computed: true,
+			},
+			"private_key_plaintext": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrPublicKey: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (e *dataKeyPairEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	conn := e.Meta().KMSClient(ctx)
+	data := dataKeyPairEphemeralResourceModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := kms.GenerateDataKeyPairInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	input.EncryptionContext = fwflex.ExpandFrameworkStringValueMap(ctx, data.Context)
+
+	output, err := conn.GenerateDataKeyPair(ctx, &input)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.KMS, create.ErrActionReading, ERNameDataKeyPair, data.KeyID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.KeyARN = fwflex.StringToFramework(ctx, output.KeyId)
+	data.PrivateKeyCiphertextBlob = types.StringValue(inttypes.Base64Encode(output.PrivateKeyCiphertextBlob))
+	data.PrivateKeyPlaintext = types.StringValue(inttypes.Base64Encode(output.PrivateKeyPlaintext))
+	data.PublicKey = types.StringValue(inttypes.Base64Encode(output.PublicKey))
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type dataKeyPairEphemeralResourceModel struct {
+	framework.WithRegionModel
+	Context                  fwtypes.MapOfString                          `tfsdk:"context" autoflex:"-"`
+	GrantTokens              fwtypes.ListOfString                         `tfsdk:"grant_tokens"`
+	KeyARN                   types.String                                 `tfsdk:"key_arn" autoflex:"-"`
+	KeyID                    types.String                                 `tfsdk:"key_id"`
+	KeyPairSpec              fwtypes.StringEnum[awstypes.DataKeyPairSpec] `tfsdk:"key_pair_spec"`
+	PrivateKeyCiphertextBlob types.String                                 `tfsdk:"private_key_ciphertext_blob" autoflex:"-"`
+	PrivateKeyPlaintext      types.String                                 `tfsdk:"private_key_plaintext" autoflex:"-"`
+	PublicKey                types.String                                 `tfsdk:"public_key" autoflex:"-"`
+}
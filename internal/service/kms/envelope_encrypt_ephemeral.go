@@ -0,0 +1,106 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// @EphemeralResource(aws_kms_envelope_encrypt, name="Envelope Encrypt")
+// @Region(overrideEnabled=false)
+func newEnvelopeEncryptEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &envelopeEncryptEphemeralResource{}, nil
+}
+
+type envelopeEncryptEphemeralResource struct {
+	framework.EphemeralResourceWithModel[envelopeEncryptEphemeralResourceModel]
+}
+
+func (e *envelopeEncryptEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"additional_data": schema.StringAttribute{
+				Optional: true,
+			},
+			"ciphertext": schema.StringAttribute{
+				Computed: true,
+			},
+			"data_key": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+			"plaintext": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *envelopeEncryptEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	data := envelopeEncryptEphemeralResourceModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	key, err := inttypes.Base64Decode(data.DataKey.ValueString())
+	if err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("data_key"), "invalid base64 value for data key", err.Error())
+		return
+	}
+
+	ciphertext, err := envelopeEncrypt(key, []byte(data.Plaintext.ValueString()), []byte(data.AdditionalData.ValueString()))
+	if err != nil {
+		response.Diagnostics.AddError(
+			"failed to encrypt plaintext",
+			err.Error(),
+		)
+		return
+	}
+
+	data.Ciphertext = types.StringValue(inttypes.Base64Encode(ciphertext))
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type envelopeEncryptEphemeralResourceModel struct {
+	AdditionalData types.String `tfsdk:"additional_data"`
+	Ciphertext     types.String `tfsdk:"ciphertext"`
+	DataKey        types.String `tfsdk:"data_key"`
+	Plaintext      types.String `tfsdk:"plaintext"`
+}
+
+// envelopeEncrypt encrypts plaintext with the specified AES data key using AES-GCM.
+// The returned value is the random nonce followed by the sealed ciphertext and authentication tag.
+func envelopeEncrypt(key, plaintext, additionalData []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("data key: %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
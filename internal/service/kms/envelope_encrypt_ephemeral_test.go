@@ -0,0 +1,126 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kms_test
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestEnvelopeEncrypt(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		key            []byte
+		plaintext      []byte
+		additionalData []byte
+		expectError    bool
+	}{
+		"AES-256": {
+			key:       bytes.Repeat([]byte{0x01}, 32),
+			plaintext: []byte("my-plaintext-string"),
+		},
+		"AES-128 with additional data": {
+			key:            bytes.Repeat([]byte{0x02}, 16),
+			plaintext:      []byte("my-plaintext-string"),
+			additionalData: []byte("context"),
+		},
+		"empty plaintext": {
+			key: bytes.Repeat([]byte{0x03}, 32),
+		},
+		"invalid key size": {
+			key:         bytes.Repeat([]byte{0x04}, 7),
+			plaintext:   []byte("my-plaintext-string"),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ciphertext, err := tfkms.EnvelopeEncrypt(testCase.key, testCase.plaintext, testCase.additionalData)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("EnvelopeEncrypt() err %t, want %t: %v", got, want, err)
+			}
+			if err != nil {
+				return
+			}
+
+			block, err := aes.NewCipher(testCase.key)
+			if err != nil {
+				t.Fatal(err)
+			}
+			aead, err := cipher.NewGCM(block)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			nonce, sealed := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+			plaintext, err := aead.Open(nil, nonce, sealed, testCase.additionalData)
+			if err != nil {
+				t.Fatalf("decrypting: %s", err)
+			}
+
+			if !bytes.Equal(plaintext, testCase.plaintext) {
+				t.Errorf("decrypted %q, want %q", plaintext, testCase.plaintext)
+			}
+		})
+	}
+}
+
+func TestAccKMSEnvelopeEncryptEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.KMSServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvelopeEncryptEphemeralResourceConfig_basic(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("ciphertext"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func testAccEnvelopeEncryptEphemeralResourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_kms_envelope_encrypt.test"),
+		testAccDataKeyEphemeralResourceConfig_key(rName),
+		fmt.Sprintf(`
+ephemeral "aws_kms_data_key" "test" {
+  key_id = aws_kms_key.test.key_id
+}
+
+ephemeral "aws_kms_envelope_encrypt" "test" {
+  data_key  = ephemeral.aws_kms_data_key.test.plaintext
+  plaintext = %[1]q
+}
+`, rName))
+}
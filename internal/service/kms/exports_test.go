@@ -16,6 +16,7 @@ var (
 	ResourceReplicaKey         = resourceReplicaKey
 
 	AliasARNToKeyARN          = aliasARNToKeyARN
+	EnvelopeEncrypt           = envelopeEncrypt
 	AliasNamePrefix           = aliasNamePrefix
 	FindCustomKeyStoreByID    = findCustomKeyStoreByID
 	FindGrantByTwoPartKey     = findGrantByTwoPartKey
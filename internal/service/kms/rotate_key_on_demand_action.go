@@ -0,0 +1,231 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// rotateKeyOnDemandPollInterval defines polling cadence for rotate key on demand action.
+const rotateKeyOnDemandPollInterval = 10 * time.Second
+
+const (
+	rotateKeyOnDemandStatusInProgress = "IN_PROGRESS"
+	rotateKeyOnDemandStatusCompleted  = "COMPLETED"
+)
+
+// @Action(aws_kms_rotate_key_on_demand, name="Rotate Key On Demand")
+func newRotateKeyOnDemandAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &rotateKeyOnDemandAction{}, nil
+}
+
+var (
+	_ action.Action = (*rotateKeyOnDemandAction)(nil)
+)
+
+type rotateKeyOnDemandAction struct {
+	framework.ActionWithModel[rotateKeyOnDemandActionModel]
+}
+
+type rotateKeyOnDemandActionModel struct {
+	framework.WithRegionModel
+	KeyID   types.String `tfsdk:"key_id"`
+	Timeout types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *rotateKeyOnDemandAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Immediately initiates rotation of the key material of a symmetric encryption KMS key and waits for the rotation to complete.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrKeyID: schema.StringAttribute{
+				Description: "The key ID or key ARN of the KMS key to rotate",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 2048),
+				},
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the rotation to complete (default: 600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(30),
+					int64validator.AtMost(3600),
+				},
+			},
+		},
+	}
+}
+
+func (a *rotateKeyOnDemandAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config rotateKeyOnDemandActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().KMSClient(ctx)
+
+	keyID := config.KeyID.ValueString()
+
+	timeout := 600 * time.Second
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting KMS rotate key on demand action", map[string]any{
+		names.AttrKeyID:   keyID,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting on-demand rotation of KMS key %s...", keyID),
+	})
+
+	key, err := findKeyByID(ctx, conn, keyID)
+	if err != nil {
+		if retry.NotFound(err) {
+			resp.Diagnostics.AddError(
+				"Key Not Found",
+				fmt.Sprintf("KMS key %s was not found", keyID),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to Describe Key",
+			fmt.Sprintf("Could not describe KMS key %s: %s", keyID, err),
+		)
+		return
+	}
+
+	if key.KeyState != awstypes.KeyStateEnabled {
+		resp.Diagnostics.AddError(
+			"Cannot Rotate Key",
+			fmt.Sprintf("KMS key %s is in state '%s' and cannot be rotated. Key must be in '%s' state.", keyID, key.KeyState, awstypes.KeyStateEnabled),
+		)
+		return
+	}
+
+	if key.KeySpec != awstypes.KeySpecSymmetricDefault {
+		resp.Diagnostics.AddError(
+			"Cannot Rotate Key",
+			fmt.Sprintf("KMS key %s has key spec '%s'. Only '%s' keys support on-demand rotation.", keyID, key.KeySpec, awstypes.KeySpecSymmetricDefault),
+		)
+		return
+	}
+
+	// Record the completed on-demand rotations before starting a new one. GetKeyRotationStatus doesn't report
+	// an on-demand rotation until it has started, so the rotation is only complete once a new entry is listed.
+	rotations, err := findOnDemandKeyRotationsByKeyID(ctx, conn, keyID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to List Key Rotations",
+			fmt.Sprintf("Could not list rotations of KMS key %s: %s", keyID, err),
+		)
+		return
+	}
+	previousRotations := len(rotations)
+
+	input := kms.RotateKeyOnDemandInput{
+		KeyId: aws.String(keyID),
+	}
+
+	_, err = conn.RotateKeyOnDemand(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Rotate Key",
+			fmt.Sprintf("Could not start on-demand rotation of KMS key %s: %s", keyID, err),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("On-demand rotation started for KMS key %s, waiting for completion...", keyID),
+	})
+
+	_, err = actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[struct{}], error) {
+		rotations, derr := findOnDemandKeyRotationsByKeyID(ctx, conn, keyID)
+		if derr != nil {
+			return actionwait.FetchResult[struct{}]{}, fmt.Errorf("listing key rotations: %w", derr)
+		}
+		if len(rotations) > previousRotations {
+			return actionwait.FetchResult[struct{}]{Status: rotateKeyOnDemandStatusCompleted}, nil
+		}
+		return actionwait.FetchResult[struct{}]{Status: rotateKeyOnDemandStatusInProgress}, nil
+	}, actionwait.Options[struct{}]{
+		Timeout:            timeout,
+		Interval:           actionwait.FixedInterval(rotateKeyOnDemandPollInterval),
+		ProgressInterval:   30 * time.Second,
+		SuccessStates:      []actionwait.Status{rotateKeyOnDemandStatusCompleted},
+		TransitionalStates: []actionwait.Status{rotateKeyOnDemandStatusInProgress},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("On-demand rotation of KMS key %s is currently %s, continuing to wait...", keyID, fr.Status)})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Key Rotation",
+				fmt.Sprintf("On-demand rotation of KMS key %s did not complete within %s: %s", keyID, timeout, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Key Rotation",
+				fmt.Sprintf("Error while waiting for on-demand rotation of KMS key %s: %s", keyID, err),
+			)
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("KMS key %s has been successfully rotated", keyID),
+	})
+
+	tflog.Info(ctx, "KMS rotate key on demand action completed successfully", map[string]any{
+		names.AttrKeyID: keyID,
+	})
+}
+
+func findOnDemandKeyRotationsByKeyID(ctx context.Context, conn *kms.Client, keyID string) ([]awstypes.RotationsListEntry, error) {
+	input := kms.ListKeyRotationsInput{
+		KeyId: aws.String(keyID),
+	}
+	var output []awstypes.RotationsListEntry
+
+	pages := kms.NewListKeyRotationsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return output, err
+		}
+
+		for _, v := range page.Rotations {
+			if v.RotationType == awstypes.RotationTypeOnDemand {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
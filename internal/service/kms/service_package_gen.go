@@ -22,8 +22,36 @@ import (
 
 type servicePackage struct{}
 
+func (p *servicePackage) Actions(ctx context.Context) []*inttypes.ServicePackageAction {
+	return []*inttypes.ServicePackageAction{
+		{
+			Factory:  newRotateKeyOnDemandAction,
+			TypeName: "aws_kms_rotate_key_on_demand",
+			Name:     "Rotate Key On Demand",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+	}
+}
 func (p *servicePackage) EphemeralResources(ctx context.Context) []*inttypes.ServicePackageEphemeralResource {
 	return []*inttypes.ServicePackageEphemeralResource{
+		{
+			Factory:  newDataKeyEphemeralResource,
+			TypeName: "aws_kms_data_key",
+			Name:     "Data Key",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  newDataKeyPairEphemeralResource,
+			TypeName: "aws_kms_data_key_pair",
+			Name:     "Data Key Pair",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  newEnvelopeEncryptEphemeralResource,
+			TypeName: "aws_kms_envelope_encrypt",
+			Name:     "Envelope Encrypt",
+			Region:   unique.Make(inttypes.ResourceRegionDisabled()),
+		},
 		{
 			Factory:  newSecretsEphemeralResource,
 			TypeName: "aws_kms_secrets",
@@ -0,0 +1,388 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package meta
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/jmespath/go-jmespath"
+)
+
+const (
+	apiCallConditionStatusMet     actionwait.Status = "MET"
+	apiCallConditionStatusPending actionwait.Status = "PENDING"
+)
+
+// @Action(aws_api_call, name="API Call")
+func newAPICallAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &apiCallAction{}, nil
+}
+
+var (
+	_ action.Action = (*apiCallAction)(nil)
+)
+
+type apiCallAction struct {
+	framework.ActionWithModel[apiCallActionModel]
+}
+
+type apiCallActionModel struct {
+	framework.WithRegionModel
+	Service   types.String                                      `tfsdk:"service"`
+	Operation types.String                                      `tfsdk:"operation"`
+	Input     jsontypes.Normalized                              `tfsdk:"input"`
+	Timeout   types.Int64                                       `tfsdk:"timeout"`
+	Wait      fwtypes.ListNestedObjectValueOf[apiCallWaitModel] `tfsdk:"wait"`
+}
+
+type apiCallWaitModel struct {
+	Operation types.String         `tfsdk:"operation"`
+	Input     jsontypes.Normalized `tfsdk:"input"`
+	Condition types.String         `tfsdk:"condition"`
+}
+
+func (a *apiCallAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	operationValidators := []validator.String{
+		stringvalidator.RegexMatches(
+			regexache.MustCompile(`^[A-Z][0-9A-Za-z]*$`),
+			"must be the name of an AWS SDK for Go v2 client operation (e.g., DescribeInstances)",
+		),
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Calls an arbitrary AWS API operation. Optionally polls a second operation until a JMESPath condition holds.",
+		Attributes: map[string]schema.Attribute{
+			"service": schema.StringAttribute{
+				Description: "The provider service package name or alias of the AWS service to call (e.g., ec2)",
+				Required:    true,
+			},
+			"operation": schema.StringAttribute{
+				Description: "The name of the AWS SDK for Go v2 client operation to call (e.g., DescribeInstances)",
+				Required:    true,
+				Validators:  operationValidators,
+			},
+			"input": schema.StringAttribute{
+				CustomType:  jsontypes.NormalizedType{},
+				Description: "JSON encoded input for the operation. Keys are the AWS SDK for Go v2 input structure field names",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the wait condition to hold (default: 600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"wait": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[apiCallWaitModel](ctx),
+				Description: "Polls an operation of the same service after the call until a condition holds",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"operation": schema.StringAttribute{
+							Description: "The name of the AWS SDK for Go v2 client operation to poll",
+							Required:    true,
+							Validators:  operationValidators,
+						},
+						"input": schema.StringAttribute{
+							CustomType:  jsontypes.NormalizedType{},
+							Description: "JSON encoded input for the polled operation",
+							Optional:    true,
+						},
+						names.AttrCondition: schema.StringAttribute{
+							Description: "JMESPath expression evaluated against the polled operation's output. Waiting ends when it evaluates to true",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *apiCallAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config apiCallActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := config.Service.ValueString()
+	operation := config.Operation.ValueString()
+
+	wait, diags := config.Wait.ToPtr(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var condition *jmespath.JMESPath
+	if wait != nil {
+		var err error
+		condition, err = jmespath.Compile(wait.Condition.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid Wait Condition",
+				fmt.Sprintf("Could not compile JMESPath expression %q: %s", wait.Condition.ValueString(), err),
+			)
+			return
+		}
+	}
+
+	timeout := 600 * time.Second
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	client, err := apiServiceClient(ctx, a.Meta(), service)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unsupported Service",
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Starting API call action", map[string]any{
+		"service":         service,
+		"operation":       operation,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Calling %s %s...", service, operation),
+	})
+
+	_, err = invokeAPIOperation(ctx, client, operation, config.Input.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API Call Failed",
+			fmt.Sprintf("Could not call %s %s: %s", service, operation, err),
+		)
+		return
+	}
+
+	if wait == nil {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("%s %s completed successfully", service, operation),
+		})
+
+		tflog.Info(ctx, "API call action completed successfully", map[string]any{
+			"service":   service,
+			"operation": operation,
+		})
+		return
+	}
+
+	waitOperation := wait.Operation.ValueString()
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("%s %s completed, polling %s until %q holds...", service, operation, waitOperation, wait.Condition.ValueString()),
+	})
+
+	_, err = actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[struct{}], error) {
+		output, err := invokeAPIOperation(ctx, client, waitOperation, wait.Input.ValueString())
+		if err != nil {
+			return actionwait.FetchResult[struct{}]{}, fmt.Errorf("calling %s %s: %w", service, waitOperation, err)
+		}
+
+		met, err := apiConditionHolds(condition, output)
+		if err != nil {
+			return actionwait.FetchResult[struct{}]{}, err
+		}
+
+		if met {
+			return actionwait.FetchResult[struct{}]{Status: apiCallConditionStatusMet}, nil
+		}
+		return actionwait.FetchResult[struct{}]{Status: apiCallConditionStatusPending}, nil
+	}, actionwait.Options[struct{}]{
+		Timeout:            timeout,
+		Interval:           actionwait.WithBackoffDelay(backoff.DefaultSDKv2HelperRetryCompatibleDelay()),
+		ProgressInterval:   30 * time.Second,
+		SuccessStates:      []actionwait.Status{apiCallConditionStatusMet},
+		TransitionalStates: []actionwait.Status{apiCallConditionStatusPending},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Condition %q does not yet hold, continuing to poll %s...", wait.Condition.ValueString(), waitOperation)})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Condition",
+				fmt.Sprintf("Condition %q on %s %s did not hold within %s: %s", wait.Condition.ValueString(), service, waitOperation, timeout, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Condition",
+				fmt.Sprintf("Error while polling %s %s: %s", service, waitOperation, err),
+			)
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("%s %s completed successfully and condition %q holds", service, operation, wait.Condition.ValueString()),
+	})
+
+	tflog.Info(ctx, "API call action completed successfully", map[string]any{
+		"service":        service,
+		"operation":      operation,
+		"wait_operation": waitOperation,
+	})
+}
+
+// apiServiceClient returns the AWS SDK for Go v2 API client for the specified service package
+// or service alias using the generated AWSClient accessor methods.
+func apiServiceClient(ctx context.Context, c *conns.AWSClient, service string) (reflect.Value, error) {
+	providerNameUpper, err := names.ProviderNameUpper(service)
+	if err != nil {
+		servicePackage, aliasErr := names.ProviderPackageForAlias(service)
+		if aliasErr != nil {
+			return reflect.Value{}, fmt.Errorf("unknown service %q: %w", service, err)
+		}
+		if providerNameUpper, err = names.ProviderNameUpper(servicePackage); err != nil {
+			return reflect.Value{}, fmt.Errorf("unknown service %q: %w", service, err)
+		}
+	}
+
+	accessor := reflect.ValueOf(c).MethodByName(providerNameUpper + "Client")
+	if !accessor.IsValid() {
+		return reflect.Value{}, fmt.Errorf("no AWS SDK for Go v2 API client available for service %q", service)
+	}
+
+	return accessor.Call([]reflect.Value{reflect.ValueOf(ctx)})[0], nil
+}
+
+var (
+	contextType = reflect.TypeFor[context.Context]()
+	errorType   = reflect.TypeFor[error]()
+	readerType  = reflect.TypeFor[io.Reader]()
+)
+
+// invokeAPIOperation calls the named operation on an AWS SDK for Go v2 API client.
+// The operation's input structure is decoded from JSON.
+func invokeAPIOperation(ctx context.Context, client reflect.Value, operation, inputJSON string) (any, error) {
+	method := client.MethodByName(operation)
+	if !method.IsValid() {
+		return nil, fmt.Errorf("operation %s not found on %s", operation, client.Type())
+	}
+
+	// Operations have the signature func(context.Context, *XInput, ...func(*Options)) (*XOutput, error).
+	methodType := method.Type()
+	if methodType.NumIn() < 2 || methodType.In(0) != contextType || methodType.In(1).Kind() != reflect.Pointer || methodType.In(1).Elem().Kind() != reflect.Struct ||
+		methodType.NumOut() != 2 || methodType.Out(1) != errorType {
+		return nil, fmt.Errorf("%s is not an API operation on %s", operation, client.Type())
+	}
+
+	if isStreamingOutput(methodType.Out(0)) {
+		return nil, fmt.Errorf("operation %s has a streaming output and is not supported", operation)
+	}
+
+	input := reflect.New(methodType.In(1).Elem())
+	if inputJSON != "" {
+		decoder := json.NewDecoder(strings.NewReader(inputJSON))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(input.Interface()); err != nil {
+			return nil, fmt.Errorf("decoding %s input: %w", operation, err)
+		}
+	}
+
+	results := method.Call([]reflect.Value{reflect.ValueOf(ctx), input})
+	if err, ok := results[1].Interface().(error); ok && err != nil {
+		return nil, err
+	}
+
+	return results[0].Interface(), nil
+}
+
+// isStreamingOutput returns whether an operation's output structure streams its response,
+// either as a body that must be read and closed (e.g. S3 GetObject) or as an event stream (e.g. Lambda InvokeWithResponseStream).
+// The API call action only supports outputs that can be fully represented as JSON.
+func isStreamingOutput(typ reflect.Type) bool {
+	if _, ok := typ.MethodByName("GetStream"); ok {
+		return true
+	}
+
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	if typ.Kind() != reflect.Struct {
+		return false
+	}
+
+	for i := range typ.NumField() {
+		if typ := typ.Field(i).Type; typ.Kind() == reflect.Interface && typ.Implements(readerType) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// apiOutputData converts an operation's output structure into generic JSON data.
+// Response metadata is omitted.
+func apiOutputData(output any) (any, error) {
+	b, err := json.Marshal(output)
+	if err != nil {
+		return nil, fmt.Errorf("encoding output: %w", err)
+	}
+
+	var data any
+	if err := json.Unmarshal(b, &data); err != nil {
+		return nil, fmt.Errorf("decoding output: %w", err)
+	}
+
+	if m, ok := data.(map[string]any); ok {
+		delete(m, "ResultMetadata")
+	}
+
+	return data, nil
+}
+
+// apiConditionHolds reports whether the JMESPath expression evaluates to true against an operation's output.
+func apiConditionHolds(condition *jmespath.JMESPath, output any) (bool, error) {
+	data, err := apiOutputData(output)
+	if err != nil {
+		return false, err
+	}
+
+	result, err := condition.Search(data)
+	if err != nil {
+		return false, fmt.Errorf("evaluating condition: %w", err)
+	}
+
+	v, ok := result.(bool)
+
+	return ok && v, nil
+}
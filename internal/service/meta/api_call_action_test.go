@@ -0,0 +1,267 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package meta_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfmeta "github.com/hashicorp/terraform-provider-aws/internal/service/meta"
+	"github.com/jmespath/go-jmespath"
+)
+
+type testAPIClient struct{}
+
+type testAPIClientOptions struct{}
+
+type greetInput struct {
+	Name  *string
+	Count *int32
+}
+
+type greetOutput struct {
+	Greeting       *string
+	Count          int32
+	ResultMetadata middleware.Metadata
+}
+
+func (testAPIClient) Greet(ctx context.Context, params *greetInput, optFns ...func(*testAPIClientOptions)) (*greetOutput, error) {
+	if params.Name == nil {
+		return nil, errors.New("Name is required")
+	}
+
+	return &greetOutput{
+		Greeting: aws.String("Hello, " + aws.ToString(params.Name)),
+		Count:    aws.ToInt32(params.Count),
+	}, nil
+}
+
+type downloadOutput struct {
+	Body io.ReadCloser
+}
+
+func (testAPIClient) Download(ctx context.Context, params *greetInput, optFns ...func(*testAPIClientOptions)) (*downloadOutput, error) {
+	return &downloadOutput{Body: io.NopCloser(strings.NewReader("secret"))}, nil
+}
+
+type subscribeOutput struct {
+	eventStream chan string
+}
+
+func (o *subscribeOutput) GetStream() chan string {
+	return o.eventStream
+}
+
+func (testAPIClient) Subscribe(ctx context.Context, params *greetInput, optFns ...func(*testAPIClientOptions)) (*subscribeOutput, error) {
+	return &subscribeOutput{}, nil
+}
+
+func (testAPIClient) Options() testAPIClientOptions {
+	return testAPIClientOptions{}
+}
+
+func TestInvokeAPIOperation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := reflect.ValueOf(testAPIClient{})
+
+	testCases := map[string]struct {
+		operation string
+		input     string
+		expected  *greetOutput
+		wantErr   bool
+	}{
+		"valid": {
+			operation: "Greet",
+			input:     `{"Name": "World", "Count": 2}`,
+			expected:  &greetOutput{Greeting: aws.String("Hello, World"), Count: 2},
+		},
+		"operation error": {
+			operation: "Greet",
+			wantErr:   true,
+		},
+		"unknown field": {
+			operation: "Greet",
+			input:     `{"Nombre": "World"}`,
+			wantErr:   true,
+		},
+		"invalid JSON": {
+			operation: "Greet",
+			input:     `{"Name":`,
+			wantErr:   true,
+		},
+		"unknown operation": {
+			operation: "Wave",
+			wantErr:   true,
+		},
+		"not an operation": {
+			operation: "Options",
+			wantErr:   true,
+		},
+		"streaming body": {
+			operation: "Download",
+			wantErr:   true,
+		},
+		"event stream": {
+			operation: "Subscribe",
+			wantErr:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			output, err := tfmeta.InvokeAPIOperation(ctx, client, testCase.operation, testCase.input)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("InvokeAPIOperation() err %t, want %t: %v", got, want, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got, want := output.(*greetOutput), testCase.expected; !reflect.DeepEqual(got, want) {
+				t.Errorf("InvokeAPIOperation() = %#v, want %#v", got, want)
+			}
+		})
+	}
+}
+
+func TestAPIConditionHolds(t *testing.T) {
+	t.Parallel()
+
+	output := &greetOutput{Greeting: aws.String("Hello, World"), Count: 2}
+
+	testCases := map[string]struct {
+		expression string
+		expected   bool
+	}{
+		"true": {
+			expression: "Greeting == 'Hello, World'",
+			expected:   true,
+		},
+		"false": {
+			expression: "Count > `2`",
+			expected:   false,
+		},
+		"not boolean": {
+			expression: "Greeting",
+			expected:   false,
+		},
+		"result metadata omitted": {
+			expression: "ResultMetadata == null",
+			expected:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			condition := jmespath.MustCompile(testCase.expression)
+
+			got, err := tfmeta.APIConditionHolds(condition, output)
+			if err != nil {
+				t.Fatalf("APIConditionHolds() unexpected error: %s", err)
+			}
+
+			if want := testCase.expected; got != want {
+				t.Errorf("APIConditionHolds() = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestAccMetaAPICallAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPICallActionConfig_basic,
+			},
+		},
+	})
+}
+
+func TestAccMetaAPICallAction_unknownOperation(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAPICallActionConfig_unknownOperation,
+				ExpectError: regexache.MustCompile(`operation GetCallerIdentities not found`),
+			},
+		},
+	})
+}
+
+const testAccAPICallActionConfig_basic = `
+action "aws_api_call" "test" {
+  config {
+    service   = "sts"
+    operation = "GetCallerIdentity"
+
+    wait {
+      operation = "GetCallerIdentity"
+      condition = "Account != null"
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_api_call.test]
+    }
+  }
+}
+`
+
+const testAccAPICallActionConfig_unknownOperation = `
+action "aws_api_call" "test" {
+  config {
+    service   = "sts"
+    operation = "GetCallerIdentities"
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_api_call.test]
+    }
+  }
+}
+`
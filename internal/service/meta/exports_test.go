@@ -5,6 +5,8 @@ package meta
 
 // Exports for use in tests only.
 var (
+	APIConditionHolds       = apiConditionHolds
 	FindRegionByEC2Endpoint = findRegionByEC2Endpoint
 	FindRegionByName        = findRegionByName
+	InvokeAPIOperation      = invokeAPIOperation
 )
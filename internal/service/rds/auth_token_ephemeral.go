@@ -0,0 +1,134 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/rds/auth"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameAuthToken = "Ephemeral Resource Auth Token"
+)
+
+// @EphemeralResource(aws_rds_auth_token, name="Auth Token")
+func newAuthTokenEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &authTokenEphemeralResource{}, nil
+}
+
+type authTokenEphemeralResource struct {
+	framework.EphemeralResourceWithModel[authTokenEphemeralResourceModel]
+}
+
+func (e *authTokenEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"hostname": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrPort: schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrUsername: schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (e *authTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	data := authTokenEphemeralResourceModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := net.JoinHostPort(data.Hostname.ValueString(), strconv.FormatInt(data.Port.ValueInt64(), 10))
+
+	token, err := auth.BuildAuthToken(ctx, endpoint, e.Meta().Region(ctx), data.Username.ValueString(), e.Meta().CredentialsProvider(ctx))
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RDS, create.ErrActionReading, ERNameAuthToken, endpoint, err),
+			err.Error(),
+		)
+		return
+	}
+
+	expiration, err := authTokenExpiration(token)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RDS, create.ErrActionReading, ERNameAuthToken, endpoint, err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.Expiration = timetypes.NewRFC3339TimeValue(expiration)
+	data.Token = types.StringValue(token)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+// authTokenExpiration returns the expiration time of an IAM database authentication token,
+// which is a SigV4 presigned request without the URL scheme.
+func authTokenExpiration(token string) (time.Time, error) {
+	_, rawQuery, ok := strings.Cut(token, "?")
+	if !ok {
+		return time.Time{}, fmt.Errorf("auth token has no query string")
+	}
+
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parsing auth token query string: %w", err)
+	}
+
+	signingTime, err := time.Parse("20060102T150405Z", query.Get("X-Amz-Date"))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parsing auth token X-Amz-Date: %w", err)
+	}
+
+	expiresIn, err := strconv.Atoi(query.Get("X-Amz-Expires"))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parsing auth token X-Amz-Expires: %w", err)
+	}
+
+	return signingTime.Add(time.Duration(expiresIn) * time.Second), nil
+}
+
+type authTokenEphemeralResourceModel struct {
+	framework.WithRegionModel
+	Expiration timetypes.RFC3339 `tfsdk:"expiration"`
+	Hostname   types.String      `tfsdk:"hostname"`
+	Port       types.Int64       `tfsdk:"port"`
+	Token      types.String      `tfsdk:"token"`
+	Username   types.String      `tfsdk:"username"`
+}
@@ -0,0 +1,126 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/feature/rds/auth"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAuthTokenExpiration(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		token    string
+		expected time.Time
+		wantErr  bool
+	}{
+		"valid": {
+			token:    "prod-instance.us-east-1.rds.amazonaws.com:3306/?Action=connect&DBUser=mysqlUser&X-Amz-Algorithm=AWS4-HMAC-SHA256&X-Amz-Date=20261018T120000Z&X-Amz-Expires=900&X-Amz-SignedHeaders=host",
+			expected: time.Date(2026, time.October, 18, 12, 15, 0, 0, time.UTC),
+		},
+		"no query string": {
+			token:   "prod-instance.us-east-1.rds.amazonaws.com:3306/",
+			wantErr: true,
+		},
+		"no date": {
+			token:   "prod-instance.us-east-1.rds.amazonaws.com:3306/?Action=connect&X-Amz-Expires=900",
+			wantErr: true,
+		},
+		"no expires": {
+			token:   "prod-instance.us-east-1.rds.amazonaws.com:3306/?Action=connect&X-Amz-Date=20261018T120000Z",
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfrds.AuthTokenExpiration(testCase.token)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("AuthTokenExpiration() err %t, want %t: %v", got, want, err)
+			}
+
+			if err == nil && !got.Equal(testCase.expected) {
+				t.Errorf("AuthTokenExpiration() = %s, want %s", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestAuthTokenExpiration_buildAuthToken(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	credentialsProvider := credentials.NewStaticCredentialsProvider("AKIDEXAMPLE", "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY", "")
+
+	before := time.Now().UTC().Truncate(time.Second)
+	token, err := auth.BuildAuthToken(ctx, "prod-instance.us-east-1.rds.amazonaws.com:3306", "us-east-1", "mysqlUser", credentialsProvider)
+	if err != nil {
+		t.Fatalf("BuildAuthToken() unexpected error: %s", err)
+	}
+
+	got, err := tfrds.AuthTokenExpiration(token)
+	if err != nil {
+		t.Fatalf("AuthTokenExpiration() unexpected error: %s", err)
+	}
+
+	if got.Before(before.Add(15*time.Minute)) || got.After(time.Now().UTC().Add(15*time.Minute)) {
+		t.Errorf("AuthTokenExpiration() = %s, want 15 minutes after %s", got, before)
+	}
+}
+
+func TestAccRDSAuthTokenEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.RDSServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthTokenEphemeralResourceConfig_basic(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("token"), knownvalue.StringRegexp(regexache.MustCompile(`^example\.cluster\.local:5432/\?Action=connect&DBUser=iam_user&X-Amz-Algorithm=AWS4-HMAC-SHA256&`))),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("expiration"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func testAccAuthTokenEphemeralResourceConfig_basic() string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_rds_auth_token.test"),
+		fmt.Sprintf(`
+ephemeral "aws_rds_auth_token" "test" {
+  hostname = %[1]q
+  port     = 5432
+  username = "iam_user"
+}
+`, "example.cluster.local"))
+}
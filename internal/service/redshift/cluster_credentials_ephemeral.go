@@ -0,0 +1,117 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package redshift
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/redshift"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameClusterCredentials = "Ephemeral Resource Cluster Credentials"
+)
+
+// @EphemeralResource(aws_redshift_cluster_credentials, name="Cluster Credentials")
+func newClusterCredentialsEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &clusterCredentialsEphemeralResource{}, nil
+}
+
+type clusterCredentialsEphemeralResource struct {
+	framework.EphemeralResourceWithModel[clusterCredentialsEphemeralResourceModel]
+}
+
+func (e *clusterCredentialsEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"auto_create": schema.BoolAttribute{
+				Optional: true,
+			},
+			names.AttrClusterIdentifier: schema.StringAttribute{
+				Required: true,
+			},
+			"db_groups": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"db_name": schema.StringAttribute{
+				Optional: true,
+			},
+			"db_password": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"db_user": schema.StringAttribute{
+				Required: true,
+			},
+			"duration_seconds": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(900, 3600),
+				},
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (e *clusterCredentialsEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	conn := e.Meta().RedshiftClient(ctx)
+	data := clusterCredentialsEphemeralResourceModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := redshift.GetClusterCredentialsInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.GetClusterCredentials(ctx, &input)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Redshift, create.ErrActionReading, ERNameClusterCredentials, data.ClusterIdentifier.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	// The returned database user name is prefixed with "IAM:", keep the configured value.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithIgnoredFieldNamesAppend("DbUser"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type clusterCredentialsEphemeralResourceModel struct {
+	framework.WithRegionModel
+	AutoCreate        types.Bool          `tfsdk:"auto_create"`
+	ClusterIdentifier types.String        `tfsdk:"cluster_identifier"`
+	DBGroups          fwtypes.SetOfString `tfsdk:"db_groups"`
+	DBName            types.String        `tfsdk:"db_name"`
+	DBPassword        types.String        `tfsdk:"db_password"`
+	DBUser            types.String        `tfsdk:"db_user"`
+	DurationSeconds   types.Int64         `tfsdk:"duration_seconds"`
+	Expiration        timetypes.RFC3339   `tfsdk:"expiration"`
+}
@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package redshiftserverless
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/redshiftserverless"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameCredentials = "Ephemeral Resource Credentials"
+)
+
+// @EphemeralResource(aws_redshiftserverless_credentials, name="Credentials")
+func newCredentialsEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &credentialsEphemeralResource{}, nil
+}
+
+type credentialsEphemeralResource struct {
+	framework.EphemeralResourceWithModel[credentialsEphemeralResourceModel]
+}
+
+func (e *credentialsEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"db_name": schema.StringAttribute{
+				Optional: true,
+			},
+			"db_password": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"db_user": schema.StringAttribute{
+				Computed: true,
+			},
+			"duration_seconds": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(900, 3600),
+				},
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"workgroup_name": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (e *credentialsEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	conn := e.Meta().RedshiftServerlessClient(ctx)
+	data := credentialsEphemeralResourceModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := redshiftserverless.GetCredentialsInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.GetCredentials(ctx, &input)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RedshiftServerless, create.ErrActionReading, ERNameCredentials, data.WorkgroupName.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type credentialsEphemeralResourceModel struct {
+	framework.WithRegionModel
+	DBName          types.String      `tfsdk:"db_name"`
+	DBPassword      types.String      `tfsdk:"db_password"`
+	DBUser          types.String      `tfsdk:"db_user"`
+	DurationSeconds types.Int64       `tfsdk:"duration_seconds"`
+	Expiration      timetypes.RFC3339 `tfsdk:"expiration"`
+	WorkgroupName   types.String      `tfsdk:"workgroup_name"`
+}
@@ -0,0 +1,191 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNamePresignedURL = "Ephemeral Resource Presigned URL"
+
+	// presignedURLDefaultExpiresIn is the default validity period of a presigned URL.
+	presignedURLDefaultExpiresIn = 15 * time.Minute
+)
+
+// @EphemeralResource(aws_s3_presigned_url, name="Presigned URL")
+func newPresignedURLEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &presignedURLEphemeralResource{}, nil
+}
+
+type presignedURLEphemeralResource struct {
+	framework.EphemeralResourceWithModel[presignedURLEphemeralResourceModel]
+}
+
+func (e *presignedURLEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrBucket: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			names.AttrContentType: schema.StringAttribute{
+				Optional: true,
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"expires_in": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 604800),
+				},
+			},
+			names.AttrKey: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"method": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(http.MethodGet, http.MethodPut),
+				},
+			},
+			"signed_headers": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrURL: schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"version_id": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot(names.AttrContentType)),
+				},
+			},
+		},
+	}
+}
+
+func (e *presignedURLEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	conn := e.Meta().S3Client(ctx)
+	data := presignedURLEphemeralResourceModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bucket, key := data.Bucket.ValueString(), data.Key.ValueString()
+	if isDirectoryBucket(bucket) {
+		conn = e.Meta().S3ExpressClient(ctx)
+	}
+
+	expiresIn := presignedURLDefaultExpiresIn
+	if !data.ExpiresIn.IsNull() {
+		expiresIn = time.Duration(data.ExpiresIn.ValueInt64()) * time.Second
+	}
+
+	optFns := []func(*s3.PresignOptions){
+		s3.WithPresignExpires(expiresIn),
+	}
+	// Via S3 access point: "Invalid configuration: region from ARN `us-east-1` does not match client region `aws-global` and UseArnRegion is `false`".
+	if arn.IsARN(bucket) {
+		optFns = append(optFns, s3.WithPresignClientFromClientOptions(func(o *s3.Options) { o.UseARNRegion = true }))
+	}
+
+	presignClient := s3.NewPresignClient(conn)
+	signingTime := time.Now().UTC()
+
+	var output *v4.PresignedHTTPRequest
+	var err error
+	switch method := data.Method.ValueString(); method {
+	case "", http.MethodGet:
+		input := s3.GetObjectInput{
+			Bucket:    aws.String(bucket),
+			Key:       aws.String(key),
+			VersionId: fwflex.StringFromFramework(ctx, data.VersionID),
+		}
+		output, err = presignClient.PresignGetObject(ctx, &input, optFns...)
+	case http.MethodPut:
+		input := s3.PutObjectInput{
+			Bucket:      aws.String(bucket),
+			ContentType: fwflex.StringFromFramework(ctx, data.ContentType),
+			Key:         aws.String(key),
+		}
+		output, err = presignClient.PresignPutObject(ctx, &input, optFns...)
+	default:
+		err = fmt.Errorf("unsupported method: %s", method)
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.S3, create.ErrActionReading, ERNamePresignedURL, bucket+"/"+key, err),
+			err.Error(),
+		)
+		return
+	}
+
+	signedHeaders := make(map[string]string, len(output.SignedHeader))
+	for k, v := range output.SignedHeader {
+		// The Host header is set by HTTP clients from the URL.
+		if strings.EqualFold(k, "Host") {
+			continue
+		}
+		signedHeaders[k] = strings.Join(v, ",")
+	}
+
+	data.Expiration = timetypes.NewRFC3339TimeValue(signingTime.Add(expiresIn))
+	data.URL = types.StringValue(output.URL)
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, signedHeaders, &data.SignedHeaders)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type presignedURLEphemeralResourceModel struct {
+	framework.WithRegionModel
+	Bucket        types.String        `tfsdk:"bucket"`
+	ContentType   types.String        `tfsdk:"content_type"`
+	Expiration    timetypes.RFC3339   `tfsdk:"expiration"`
+	ExpiresIn     types.Int64         `tfsdk:"expires_in"`
+	Key           types.String        `tfsdk:"key"`
+	Method        types.String        `tfsdk:"method"`
+	SignedHeaders fwtypes.MapOfString `tfsdk:"signed_headers"`
+	URL           types.String        `tfsdk:"url"`
+	VersionID     types.String        `tfsdk:"version_id"`
+}
@@ -0,0 +1,208 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// rotateSecretPollInterval defines polling cadence for rotate secret action.
+const rotateSecretPollInterval = 10 * time.Second
+
+const (
+	rotateSecretStatusPending = "PENDING"
+	rotateSecretStatusCurrent = "CURRENT"
+)
+
+// @Action(aws_secretsmanager_rotate_secret, name="Rotate Secret")
+func newRotateSecretAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &rotateSecretAction{}, nil
+}
+
+var (
+	_ action.Action = (*rotateSecretAction)(nil)
+)
+
+type rotateSecretAction struct {
+	framework.ActionWithModel[rotateSecretActionModel]
+}
+
+type rotateSecretActionModel struct {
+	framework.WithRegionModel
+	SecretID           types.String `tfsdk:"secret_id"`
+	ClientRequestToken types.String `tfsdk:"client_request_token"`
+	Timeout            types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *rotateSecretAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Rotates a Secrets Manager secret immediately using its configured rotation function and waits for the new version to be labeled AWSCURRENT.",
+		Attributes: map[string]schema.Attribute{
+			"secret_id": schema.StringAttribute{
+				Description: "The ARN or name of the secret to rotate",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 2048),
+				},
+			},
+			"client_request_token": schema.StringAttribute{
+				Description: "Unique identifier for the new version of the secret. If not provided, Secrets Manager generates one",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(32, 64),
+				},
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the rotation to complete (default: 600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(3600),
+				},
+			},
+		},
+	}
+}
+
+func (a *rotateSecretAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config rotateSecretActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().SecretsManagerClient(ctx)
+
+	secretID := config.SecretID.ValueString()
+
+	timeout := 600 * time.Second
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting Secrets Manager rotate secret action", map[string]any{
+		"secret_id":       secretID,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting rotation of Secrets Manager secret %s...", secretID),
+	})
+
+	secret, err := findSecretByID(ctx, conn, secretID)
+	if err != nil {
+		if retry.NotFound(err) {
+			resp.Diagnostics.AddError(
+				"Secret Not Found",
+				fmt.Sprintf("Secrets Manager secret %s was not found", secretID),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to Describe Secret",
+			fmt.Sprintf("Could not describe Secrets Manager secret %s: %s", secretID, err),
+		)
+		return
+	}
+
+	if !aws.ToBool(secret.RotationEnabled) {
+		resp.Diagnostics.AddError(
+			"Rotation Not Configured",
+			fmt.Sprintf("Secrets Manager secret %s does not have rotation configured. Configure rotation with the aws_secretsmanager_secret_rotation resource before invoking this action.", secretID),
+		)
+		return
+	}
+
+	input := secretsmanager.RotateSecretInput{
+		RotateImmediately: aws.Bool(true),
+		SecretId:          aws.String(secretID),
+	}
+	if !config.ClientRequestToken.IsNull() {
+		input.ClientRequestToken = config.ClientRequestToken.ValueStringPointer()
+	}
+
+	output, err := conn.RotateSecret(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Rotate Secret",
+			fmt.Sprintf("Could not rotate Secrets Manager secret %s: %s", secretID, err),
+		)
+		return
+	}
+
+	versionID := aws.ToString(output.VersionId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Rotation started for secret %s (version %s), waiting for %s stage...", secretID, versionID, secretVersionStageCurrent),
+	})
+
+	// Rotation is complete once the rotation function has moved the AWSCURRENT
+	// staging label onto the version created by RotateSecret.
+	_, err = actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[struct{}], error) {
+		secret, derr := findSecretByID(ctx, conn, secretID)
+		if derr != nil {
+			return actionwait.FetchResult[struct{}]{}, fmt.Errorf("describing secret: %w", derr)
+		}
+		stages, ok := secret.VersionIdsToStages[versionID]
+		if !ok {
+			return actionwait.FetchResult[struct{}]{}, fmt.Errorf("version %s no longer exists", versionID)
+		}
+		if slices.Contains(stages, secretVersionStageCurrent) {
+			return actionwait.FetchResult[struct{}]{Status: rotateSecretStatusCurrent}, nil
+		}
+		return actionwait.FetchResult[struct{}]{Status: rotateSecretStatusPending}, nil
+	}, actionwait.Options[struct{}]{
+		Timeout:            timeout,
+		Interval:           actionwait.FixedInterval(rotateSecretPollInterval),
+		ProgressInterval:   30 * time.Second,
+		SuccessStates:      []actionwait.Status{rotateSecretStatusCurrent},
+		TransitionalStates: []actionwait.Status{rotateSecretStatusPending},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Secret %s version %s is not yet %s, continuing to wait...", secretID, versionID, secretVersionStageCurrent)})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Secret Rotation",
+				fmt.Sprintf("Secrets Manager secret %s version %s did not become %s within %s: %s", secretID, versionID, secretVersionStageCurrent, timeout, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Secret Rotation",
+				fmt.Sprintf("Error while waiting for Secrets Manager secret %s to rotate: %s", secretID, err),
+			)
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Secrets Manager secret %s has been successfully rotated to version %s", secretID, versionID),
+	})
+
+	tflog.Info(ctx, "Secrets Manager rotate secret action completed successfully", map[string]any{
+		"secret_id":  secretID,
+		"version_id": versionID,
+	})
+}
@@ -0,0 +1,270 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSecretsManagerRotateSecretAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckSecretDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRotateSecretActionConfig_rotation(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretCurrentVersionRotated(ctx, "aws_secretsmanager_secret_version.test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSecretsManagerRotateSecretAction_rotationNotConfigured(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckSecretDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRotateSecretActionConfig_basic(rName),
+				ExpectError: regexache.MustCompile(`Rotation Not Configured`),
+			},
+		},
+	})
+}
+
+func TestAccSecretsManagerRotateSecretAction_nonExistentSecret(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRotateSecretActionConfig_nonExistentSecret(rName),
+				ExpectError: regexache.MustCompile(`Secret Not Found`),
+			},
+		},
+	})
+}
+
+// testAccCheckSecretCurrentVersionRotated checks that the AWSCURRENT staging label has moved away from the specified secret version.
+func testAccCheckSecretCurrentVersionRotated(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecretsManagerClient(ctx)
+
+		input := secretsmanager.GetSecretValueInput{
+			SecretId:     aws.String(rs.Primary.Attributes["secret_id"]),
+			VersionStage: aws.String("AWSCURRENT"),
+		}
+		output, err := conn.GetSecretValue(ctx, &input)
+
+		if err != nil {
+			return err
+		}
+
+		if got, initial := aws.ToString(output.VersionId), rs.Primary.Attributes["version_id"]; got == initial {
+			return fmt.Errorf("Secrets Manager Secret %s AWSCURRENT version is still %s", rs.Primary.Attributes["secret_id"], initial)
+		}
+
+		return nil
+	}
+}
+
+func testAccRotateSecretActionConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id     = aws_secretsmanager_secret.test.id
+  secret_string = "test-string"
+}
+
+action "aws_secretsmanager_rotate_secret" "test" {
+  config {
+    secret_id = aws_secretsmanager_secret_version.test.secret_id
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_secretsmanager_rotate_secret.test]
+    }
+  }
+}
+`, rName)
+}
+
+func testAccRotateSecretActionConfig_nonExistentSecret(rName string) string {
+	return fmt.Sprintf(`
+action "aws_secretsmanager_rotate_secret" "test" {
+  config {
+    secret_id = %[1]q
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_secretsmanager_rotate_secret.test]
+    }
+  }
+}
+`, rName)
+}
+
+func testAccRotateSecretActionConfig_rotation(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_service_principal" "lambda" {
+  service_name = "lambda"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = { Service = data.aws_service_principal.lambda.name }
+      Action    = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Effect = "Allow"
+        Action = [
+          "secretsmanager:DescribeSecret",
+          "secretsmanager:GetSecretValue",
+          "secretsmanager:PutSecretValue",
+          "secretsmanager:UpdateSecretVersionStage",
+        ]
+        Resource = aws_secretsmanager_secret.test.arn
+      },
+      {
+        Effect   = "Allow"
+        Action   = "secretsmanager:GetRandomPassword"
+        Resource = "*"
+      },
+      {
+        Effect   = "Allow"
+        Action   = ["logs:CreateLogGroup", "logs:CreateLogStream", "logs:PutLogEvents"]
+        Resource = "arn:${data.aws_partition.current.partition}:logs:*:*:*"
+      },
+    ]
+  })
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/rotation.zip"
+  function_name = %[1]q
+  handler       = "rotation.lambda_handler"
+  role          = aws_iam_role.test.arn
+  runtime       = "python3.12"
+  timeout       = 30
+
+  depends_on = [aws_iam_role_policy.test]
+}
+
+resource "aws_lambda_permission" "test" {
+  action        = "lambda:InvokeFunction"
+  function_name = aws_lambda_function.test.function_name
+  principal     = "secretsmanager.amazonaws.com"
+  statement_id  = "AllowExecutionFromSecretsManager"
+}
+
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id     = aws_secretsmanager_secret.test.id
+  secret_string = "test-string"
+}
+
+resource "aws_secretsmanager_secret_rotation" "test" {
+  secret_id           = aws_secretsmanager_secret_version.test.secret_id
+  rotation_lambda_arn = aws_lambda_function.test.arn
+  rotate_immediately  = false
+
+  rotation_rules {
+    automatically_after_days = 30
+  }
+
+  depends_on = [aws_lambda_permission.test]
+}
+
+action "aws_secretsmanager_rotate_secret" "test" {
+  config {
+    secret_id = aws_secretsmanager_secret_rotation.test.secret_id
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_secretsmanager_rotate_secret.test]
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,27 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+# Minimal rotation function for a generic secret: each rotation stores a new random string as AWSCURRENT.
+import boto3
+
+
+def lambda_handler(event, context):
+    arn = event["SecretId"]
+    token = event["ClientRequestToken"]
+    step = event["Step"]
+    client = boto3.client("secretsmanager")
+
+    if step == "createSecret":
+        try:
+            client.get_secret_value(SecretId=arn, VersionId=token, VersionStage="AWSPENDING")
+        except client.exceptions.ResourceNotFoundException:
+            password = client.get_random_password(ExcludePunctuation=True)["RandomPassword"]
+            client.put_secret_value(SecretId=arn, ClientRequestToken=token, SecretString=password, VersionStages=["AWSPENDING"])
+    elif step == "finishSecret":
+        metadata = client.describe_secret(SecretId=arn)
+        for version, stages in metadata["VersionIdsToStages"].items():
+            if "AWSCURRENT" in stages:
+                if version != token:
+                    client.update_secret_version_stage(SecretId=arn, VersionStage="AWSCURRENT", MoveToVersionId=token, RemoveFromVersionId=version)
+                break
+    # There's nothing to set or test for a generic secret.
@@ -5,17 +5,26 @@ package sfn
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sfn/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/action/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -34,10 +43,12 @@ type startExecutionAction struct {
 
 type startExecutionActionModel struct {
 	framework.WithRegionModel
-	StateMachineArn types.String `tfsdk:"state_machine_arn"`
-	Input           types.String `tfsdk:"input"`
-	Name            types.String `tfsdk:"name"`
-	TraceHeader     types.String `tfsdk:"trace_header"`
+	StateMachineArn   types.String `tfsdk:"state_machine_arn"`
+	Input             types.String `tfsdk:"input"`
+	Name              types.String `tfsdk:"name"`
+	TraceHeader       types.String `tfsdk:"trace_header"`
+	WaitForCompletion types.Bool   `tfsdk:"wait_for_completion"`
+	Timeout           types.Int64  `tfsdk:"timeout"`
 }
 
 func (a *startExecutionAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
@@ -63,6 +74,18 @@ func (a *startExecutionAction) Schema(ctx context.Context, req action.SchemaRequ
 				Description: "AWS X-Ray trace header for distributed tracing.",
 				Optional:    true,
 			},
+			"wait_for_completion": schema.BoolAttribute{
+				Description: "Whether to wait for the execution to reach a terminal state. Only supported for Standard workflows. Defaults to false.",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in minutes to wait for the execution to complete when wait_for_completion is true. Defaults to 60.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AtMost(1440),
+				},
+			},
 		},
 	}
 }
@@ -126,4 +149,105 @@ func (a *startExecutionAction) Invoke(ctx context.Context, req action.InvokeRequ
 		"execution_arn":     executionArn,
 		"start_date":        output.StartDate,
 	})
+
+	if !config.WaitForCompletion.ValueBool() {
+		return
+	}
+
+	timeout := 60 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Minute
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Waiting for execution %s to complete...", executionArn),
+	})
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*sfn.DescribeExecutionOutput], error) {
+		output, derr := findExecutionByARN(ctx, conn, executionArn)
+		if derr != nil {
+			return actionwait.FetchResult[*sfn.DescribeExecutionOutput]{}, derr
+		}
+		return actionwait.FetchResult[*sfn.DescribeExecutionOutput]{Status: actionwait.Status(output.Status), Value: output}, nil
+	}, actionwait.Options[*sfn.DescribeExecutionOutput]{
+		Timeout:            timeout,
+		Interval:           actionwait.WithBackoffDelay(backoff.DefaultSDKv2HelperRetryCompatibleDelay()),
+		ProgressInterval:   time.Minute,
+		SuccessStates:      []actionwait.Status{actionwait.Status(awstypes.ExecutionStatusSucceeded)},
+		TransitionalStates: []actionwait.Status{actionwait.Status(awstypes.ExecutionStatusRunning)},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.ExecutionStatusFailed),
+			actionwait.Status(awstypes.ExecutionStatusTimedOut),
+			actionwait.Status(awstypes.ExecutionStatusAborted),
+			actionwait.Status(awstypes.ExecutionStatusPendingRedrive),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Execution %s is currently in state '%s'", executionArn, fr.Status)})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Step Functions Execution",
+				fmt.Sprintf("Execution %s did not complete within %s: %s", executionArn, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			message := err.Error()
+			if result.Value != nil && result.Value.Error != nil {
+				message = fmt.Sprintf("%s: %s: %s", message, aws.ToString(result.Value.Error), aws.ToString(result.Value.Cause))
+			}
+			resp.Diagnostics.AddError(
+				"Step Functions Execution Failed",
+				fmt.Sprintf("Execution %s failed: %s", executionArn, message),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Step Functions Execution Status",
+				fmt.Sprintf("Execution %s entered unexpected state: %s", executionArn, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Step Functions Execution",
+				fmt.Sprintf("Error while waiting for execution %s: %s", executionArn, err),
+			)
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Execution %s completed successfully", executionArn),
+	})
+
+	tflog.Info(ctx, "Step Functions execution completed successfully", map[string]any{
+		"execution_arn": executionArn,
+		"stop_date":     result.Value.StopDate,
+	})
+}
+
+func findExecutionByARN(ctx context.Context, conn *sfn.Client, arn string) (*sfn.DescribeExecutionOutput, error) {
+	input := &sfn.DescribeExecutionInput{
+		ExecutionArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeExecution(ctx, input)
+
+	if errs.IsA[*awstypes.ExecutionDoesNotExist](err) {
+		return nil, &sdkretry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return output, nil
 }
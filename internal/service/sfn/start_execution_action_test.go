@@ -91,6 +91,29 @@ func TestAccSFNStartExecutionAction_emptyInput(t *testing.T) {
 	})
 }
 
+func TestAccSFNStartExecutionAction_waitForCompletion(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartExecutionActionConfig_waitForCompletion(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStartExecutionActionStatus(ctx, rName, awstypes.ExecutionStatusSucceeded),
+				),
+			},
+		},
+	})
+}
+
 // Test helper functions
 
 func testAccCheckStartExecutionAction(ctx context.Context, stateMachineName, expectedInput string) resource.TestCheckFunc {
@@ -202,6 +225,48 @@ func testAccCheckStartExecutionActionWithName(ctx context.Context, stateMachineN
 	}
 }
 
+func testAccCheckStartExecutionActionStatus(ctx context.Context, stateMachineName string, expectedStatus awstypes.ExecutionStatus) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SFNClient(ctx)
+
+		// Get the state machine ARN
+		stateMachines, err := conn.ListStateMachines(ctx, &sfn.ListStateMachinesInput{})
+		if err != nil {
+			return fmt.Errorf("failed to list state machines: %w", err)
+		}
+
+		var stateMachineArn string
+		for _, sm := range stateMachines.StateMachines {
+			if *sm.Name == stateMachineName {
+				stateMachineArn = *sm.StateMachineArn
+				break
+			}
+		}
+
+		if stateMachineArn == "" {
+			return fmt.Errorf("state machine %s not found", stateMachineName)
+		}
+
+		executions, err := conn.ListExecutions(ctx, &sfn.ListExecutionsInput{
+			StateMachineArn: &stateMachineArn,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list executions for state machine %s: %w", stateMachineName, err)
+		}
+
+		if len(executions.Executions) == 0 {
+			return fmt.Errorf("no executions found for state machine %s", stateMachineName)
+		}
+
+		// The action waits for completion, so the execution must already be terminal
+		if status := executions.Executions[0].Status; status != expectedStatus {
+			return fmt.Errorf("execution status mismatch. Expected: %s, Got: %s", expectedStatus, status)
+		}
+
+		return nil
+	}
+}
+
 // Configuration functions
 
 func testAccStartExecutionActionConfig_basic(rName, inputJSON string) string {
@@ -273,6 +338,30 @@ resource "terraform_data" "trigger" {
 `)
 }
 
+func testAccStartExecutionActionConfig_waitForCompletion(rName string) string {
+	return acctest.ConfigCompose(
+		testAccStartExecutionActionConfig_base(rName),
+		`
+action "aws_sfn_start_execution" "test" {
+  config {
+    state_machine_arn   = aws_sfn_state_machine.test.arn
+    wait_for_completion = true
+    timeout             = 10
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_sfn_start_execution.test]
+    }
+  }
+}
+`)
+}
+
 func testAccStartExecutionActionConfig_base(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_role" "for_lambda" {
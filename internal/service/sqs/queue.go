@@ -18,7 +18,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
 	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
-	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -30,6 +29,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
 	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
@@ -123,10 +123,7 @@ var (
 			ValidateFunc:          validation.StringIsJSON,
 			DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
 			DiffSuppressOnRefresh: true,
-			StateFunc: func(v any) string {
-				json, _ := structure.NormalizeJsonString(v)
-				return json
-			},
+			StateFunc:             sdkv2.CanonicalizeIAMPolicyDocumentSchemaStateFunc,
 		},
 		"receive_wait_time_seconds": {
 			Type:     schema.TypeInt,
@@ -564,7 +561,7 @@ func statusQueueAttributeState(ctx context.Context, conn *sqs.Client, url string
 
 				switch k {
 				case types.QueueAttributeNamePolicy:
-					equivalent, err := awspolicy.PoliciesAreEquivalent(g, e)
+					equivalent, err := iampolicy.Equivalent(g, e)
 
 					if err != nil {
 						return queueAttributeStateNotEqual
@@ -0,0 +1,86 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package wafv2
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/autoflex/codegen"
+)
+
+// TestAutoFlexCodeGen generates autoflex_gen.go. Run `go generate` to regenerate the file.
+func TestAutoFlexCodeGen(t *testing.T) {
+	t.Parallel()
+
+	g := codegen.NewCodeGenerator(reflect.TypeFor[ruleActionOverrideModel]().PkgPath(), "wafv2", codegen.Options{})
+	g.Expander("expandRuleActionOverrideModel", ruleActionOverrideModel{}, awstypes.RuleActionOverride{})
+	g.Flattener("flattenRuleActionOverrideModel", awstypes.RuleActionOverride{}, ruleActionOverrideModel{})
+
+	codegen.Generate(t, "autoflex_gen.go", g)
+}
+
+func TestRuleActionOverrideAutoFlexCodeGen(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	header := func(name, value string) *customHTTPHeaderModel {
+		return &customHTTPHeaderModel{
+			Name:  types.StringValue(name),
+			Value: types.StringValue(value),
+		}
+	}
+	tfObject := ruleActionOverrideModel{
+		Name: types.StringValue("rule1"),
+		ActionToUse: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &ruleActionModel{
+			Allow: fwtypes.NewListNestedObjectValueOfNull[allowActionModel](ctx),
+			Block: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &blockActionModel{
+				CustomResponse: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &customResponseModel{
+					CustomResponseBodyKey: types.StringValue("body1"),
+					ResponseCode:          types.Int32Value(403),
+					ResponseHeader:        fwtypes.NewListNestedObjectValueOfSliceMust(ctx, []*customHTTPHeaderModel{header("x-a", "a"), header("x-b", "b")}),
+				}),
+			}),
+			Captcha: fwtypes.NewListNestedObjectValueOfNull[captchaActionModel](ctx),
+			Challenge: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &challengeActionModel{
+				CustomRequestHandling: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &customRequestHandlingModel{
+					InsertHeader: fwtypes.NewListNestedObjectValueOfSliceMust(ctx, []*customHTTPHeaderModel{header("x-c", "c")}),
+				}),
+			}),
+			Count: fwtypes.NewListNestedObjectValueOfNull[countActionModel](ctx),
+		}),
+	}
+
+	var want, got awstypes.RuleActionOverride
+	if diags := fwflex.Expand(ctx, tfObject, &want); diags.HasError() {
+		t.Fatalf("Expand: %v", diags)
+	}
+	if diags := expandRuleActionOverrideModel(ctx, tfObject, &got); diags.HasError() {
+		t.Fatalf("expandRuleActionOverrideModel: %v", diags)
+	}
+	if diff := cmp.Diff(got, want, cmpopts.IgnoreUnexported(awstypes.RuleActionOverride{}, awstypes.RuleAction{}, awstypes.BlockAction{}, awstypes.ChallengeAction{}, awstypes.CustomResponse{}, awstypes.CustomRequestHandling{}, awstypes.CustomHTTPHeader{})); diff != "" {
+		t.Errorf("expandRuleActionOverrideModel differs from Expand (-got, +want): %s", diff)
+	}
+	if got := aws.ToInt32(got.ActionToUse.Block.CustomResponse.ResponseCode); got != 403 {
+		t.Errorf("ResponseCode = %d, want 403", got)
+	}
+
+	var wantModel, gotModel ruleActionOverrideModel
+	if diags := fwflex.Flatten(ctx, want, &wantModel); diags.HasError() {
+		t.Fatalf("Flatten: %v", diags)
+	}
+	if diags := flattenRuleActionOverrideModel(ctx, want, &gotModel); diags.HasError() {
+		t.Fatalf("flattenRuleActionOverrideModel: %v", diags)
+	}
+	if diff := cmp.Diff(gotModel, wantModel); diff != "" {
+		t.Errorf("flattenRuleActionOverrideModel differs from Flatten (-got, +want): %s", diff)
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -29,7 +30,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
-	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
@@ -514,7 +514,9 @@ func (r *resourceWebACLRuleGroupAssociation) Create(ctx context.Context, req res
 
 			// Add rule action overrides if specified
 			if !ruleGroupRefModel.RuleActionOverride.IsNull() && !ruleGroupRefModel.RuleActionOverride.IsUnknown() {
-				resp.Diagnostics.Append(fwflex.Expand(ctx, ruleGroupRefModel.RuleActionOverride, &ruleActionOverrides)...)
+				var diags diag.Diagnostics
+				ruleActionOverrides, diags = expandRuleActionOverrideModels(ctx, ruleGroupRefModel.RuleActionOverride)
+				resp.Diagnostics.Append(diags...)
 				if resp.Diagnostics.HasError() {
 					return
 				}
@@ -553,7 +555,9 @@ func (r *resourceWebACLRuleGroupAssociation) Create(ctx context.Context, req res
 
 			// Add rule action overrides if specified
 			if !managedRuleGroupRef.RuleActionOverride.IsNull() && !managedRuleGroupRef.RuleActionOverride.IsUnknown() {
-				resp.Diagnostics.Append(fwflex.Expand(ctx, managedRuleGroupRef.RuleActionOverride, &ruleActionOverrides)...)
+				var diags diag.Diagnostics
+				ruleActionOverrides, diags = expandRuleActionOverrideModels(ctx, managedRuleGroupRef.RuleActionOverride)
+				resp.Diagnostics.Append(diags...)
 				if resp.Diagnostics.HasError() {
 					return
 				}
@@ -709,7 +713,9 @@ func (r *resourceWebACLRuleGroupAssociation) Read(ctx context.Context, req resou
 						matchesRuleGroup = true
 						// Handle rule action overrides with autoflex
 						if rule.Statement.RuleGroupReferenceStatement.RuleActionOverrides != nil {
-							resp.Diagnostics.Append(fwflex.Flatten(ctx, rule.Statement.RuleGroupReferenceStatement.RuleActionOverrides, &ruleActionOverrides)...)
+							var diags diag.Diagnostics
+							ruleActionOverrides, diags = flattenRuleActionOverrideModels(ctx, rule.Statement.RuleGroupReferenceStatement.RuleActionOverrides)
+							resp.Diagnostics.Append(diags...)
 							if resp.Diagnostics.HasError() {
 								return
 							}
@@ -749,7 +755,9 @@ func (r *resourceWebACLRuleGroupAssociation) Read(ctx context.Context, req resou
 							matchesRuleGroup = true
 							// Handle rule action overrides with autoflex
 							if managedStmt.RuleActionOverrides != nil {
-								resp.Diagnostics.Append(fwflex.Flatten(ctx, managedStmt.RuleActionOverrides, &ruleActionOverrides)...)
+								var diags diag.Diagnostics
+								ruleActionOverrides, diags = flattenRuleActionOverrideModels(ctx, managedStmt.RuleActionOverrides)
+								resp.Diagnostics.Append(diags...)
 								if resp.Diagnostics.HasError() {
 									return
 								}
@@ -873,7 +881,9 @@ func (r *resourceWebACLRuleGroupAssociation) Update(ctx context.Context, req res
 					}
 
 					if !ruleGroupRefModel.RuleActionOverride.IsNull() && !ruleGroupRefModel.RuleActionOverride.IsUnknown() {
-						resp.Diagnostics.Append(fwflex.Expand(ctx, ruleGroupRefModel.RuleActionOverride, &overrides)...)
+						var diags diag.Diagnostics
+						overrides, diags = expandRuleActionOverrideModels(ctx, ruleGroupRefModel.RuleActionOverride)
+						resp.Diagnostics.Append(diags...)
 						if resp.Diagnostics.HasError() {
 							return
 						}
@@ -889,7 +899,9 @@ func (r *resourceWebACLRuleGroupAssociation) Update(ctx context.Context, req res
 					}
 
 					if !managedRuleGroupRef.RuleActionOverride.IsNull() && !managedRuleGroupRef.RuleActionOverride.IsUnknown() {
-						resp.Diagnostics.Append(fwflex.Expand(ctx, managedRuleGroupRef.RuleActionOverride, &overrides)...)
+						var diags diag.Diagnostics
+						overrides, diags = expandRuleActionOverrideModels(ctx, managedRuleGroupRef.RuleActionOverride)
+						resp.Diagnostics.Append(diags...)
 						if resp.Diagnostics.HasError() {
 							return
 						}
@@ -1254,3 +1266,41 @@ type customHTTPHeaderModel struct {
 	Name  types.String `tfsdk:"name"`
 	Value types.String `tfsdk:"value"`
 }
+
+func expandRuleActionOverrideModels(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[ruleActionOverrideModel]) ([]awstypes.RuleActionOverride, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tfObjects, d := tfList.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	apiObjects := make([]awstypes.RuleActionOverride, len(tfObjects))
+	for i, tfObject := range tfObjects {
+		diags.Append(expandRuleActionOverrideModel(ctx, *tfObject, &apiObjects[i])...)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	return apiObjects, diags
+}
+
+func flattenRuleActionOverrideModels(ctx context.Context, apiObjects []awstypes.RuleActionOverride) (fwtypes.ListNestedObjectValueOf[ruleActionOverrideModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tfObjects := make([]*ruleActionOverrideModel, len(apiObjects))
+	for i, apiObject := range apiObjects {
+		tfObjects[i] = new(ruleActionOverrideModel)
+		diags.Append(flattenRuleActionOverrideModel(ctx, apiObject, tfObjects[i])...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfNull[ruleActionOverrideModel](ctx), diags
+		}
+	}
+
+	tfList, d := fwtypes.NewListNestedObjectValueOfSlice(ctx, tfObjects, nil)
+	diags.Append(d...)
+
+	return tfList, diags
+}
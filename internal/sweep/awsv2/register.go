@@ -7,13 +7,12 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/log"
 )
 
 func Register(name string, f sweep.SweeperFn, dependencies ...string) {
-	resource.AddTestSweepers(name, &resource.Sweeper{
+	sweep.Register(&sweep.Sweeper{
 		Name: name,
 		F: func(region string) error {
 			ctx := sweep.Context(region)
@@ -44,5 +43,6 @@ func Register(name string, f sweep.SweeperFn, dependencies ...string) {
 			return nil
 		},
 		Dependencies: dependencies,
+		List:         f,
 	})
 }
@@ -0,0 +1,117 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// The sweeper command deletes leftover acceptance test resources outside of `go test -sweep`.
+// See README.md for usage.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/listresource"
+)
+
+func main() {
+	var (
+		dryRun      = flag.Bool("dry-run", false, "list the resources that would be deleted without deleting them")
+		regions     = flag.String("regions", "us-west-2,us-east-1", "comma-separated list of Regions to sweep")
+		roleARNs    = flag.String("assume-role-arns", "", "comma-separated list of ARNs of IAM roles to assume, one per account to sweep (default is the credentials from the environment)")
+		sweepers    = flag.String("sweepers", "", "comma-separated list of sweeper name substrings; matching sweepers and their dependencies are run (default is all sweepers)")
+		reportPath  = flag.String("report", "", "path to write the JSON report to (default is standard output)")
+		list        = flag.Bool("list", false, "list the selected sweepers in dependency order and exit")
+		nameFilters []*regexp.Regexp
+	)
+	flag.Func("name-filter", "regular expression that resource names must match to be deleted; may be repeated", func(s string) error {
+		re, err := regexp.Compile(s)
+		if err != nil {
+			return err
+		}
+
+		nameFilters = append(nameFilters, re)
+
+		return nil
+	})
+	flag.Parse()
+
+	ctx := context.Background()
+
+	sweep.ServicePackages = servicePackages(ctx)
+	registerSweepers()
+	listresource.RegisterSweepers(ctx, sweep.ServicePackages)
+
+	r, err := newRunner(sweep.Sweepers(), splitList(*sweepers), clientWithAssumeRole, runnerOptions{
+		dryRun:      *dryRun,
+		nameFilters: nameFilters,
+		regions:     splitList(*regions),
+		roleARNs:    splitList(*roleARNs),
+	})
+	if err != nil {
+		fatalf("%s", err)
+	}
+
+	if *list {
+		for _, name := range r.order {
+			fmt.Fprintln(os.Stdout, name)
+		}
+		return
+	}
+
+	report := r.run(ctx)
+
+	var w io.Writer = os.Stdout
+	if *reportPath != "" {
+		f, err := os.Create(*reportPath)
+		if err != nil {
+			fatalf("creating report: %s", err)
+		}
+		defer f.Close()
+
+		w = f
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		fatalf("writing report: %s", err)
+	}
+
+	fmt.Fprintf(os.Stderr, "%d deleted, %d skipped, %d failed; %d sweepers swept without listing, %d unsupported\n", report.Deleted, report.Skipped, report.Failed, report.Swept, report.Unsupported)
+
+	if report.Failed > 0 {
+		os.Exit(1) //nolint:gocritic // exitAfterDefer: the report has been written.
+	}
+}
+
+func clientWithAssumeRole(ctx context.Context, region, roleARN string) (*conns.AWSClient, error) {
+	if roleARN == "" {
+		return sweep.SharedRegionalSweepClient(ctx, region)
+	}
+
+	return sweep.SharedRegionalSweepClientWithAssumeRole(ctx, region, roleARN)
+}
+
+func splitList(s string) []string {
+	var result []string
+
+	for v := range strings.SplitSeq(s, ",") {
+		if v := strings.TrimSpace(v); v != "" {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+func fatalf(format string, a ...any) {
+	fmt.Fprintf(os.Stderr, "sweeper: "+format+"\n", a...)
+	os.Exit(1)
+}
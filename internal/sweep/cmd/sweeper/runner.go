@@ -0,0 +1,295 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/log"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
+)
+
+type resultStatus string
+
+const (
+	resultStatusDeleted resultStatus = "deleted"
+	resultStatusFailed  resultStatus = "failed"
+	resultStatusSkipped resultStatus = "skipped"
+	// resultStatusSwept is the status of a sweeper that doesn't support listing resources and ran successfully.
+	// The number of resources it deleted isn't known.
+	resultStatusSwept resultStatus = "swept"
+	// resultStatusUnsupported is the status of a sweeper that doesn't support listing resources
+	// and wasn't run because it can't honor the runner's options.
+	resultStatusUnsupported resultStatus = "unsupported"
+)
+
+// result is the result of sweeping a single resource.
+// Sweepers that don't support listing resources report a single result for the sweeper as a whole,
+// with no ID or name and a status of swept, unsupported or failed.
+type result struct {
+	Account string       `json:"account,omitempty"` // The ARN of the assumed IAM role. Empty for the default credentials.
+	Region  string       `json:"region"`
+	Sweeper string       `json:"sweeper"`
+	ID      string       `json:"id,omitempty"`
+	Name    string       `json:"name,omitempty"`
+	Status  resultStatus `json:"status"`
+	Reason  string       `json:"reason,omitempty"`
+}
+
+// report is the JSON report written once all sweepers have run.
+// The counts of deleted and skipped resources don't include resources deleted by sweepers that don't support listing resources,
+// which are counted as swept or unsupported sweepers instead.
+type report struct {
+	DryRun      bool     `json:"dry_run"`
+	Deleted     int      `json:"deleted"`
+	Skipped     int      `json:"skipped"`
+	Failed      int      `json:"failed"`
+	Swept       int      `json:"swept"`
+	Unsupported int      `json:"unsupported"`
+	Results     []result `json:"results"`
+}
+
+type clientFunc func(ctx context.Context, region, roleARN string) (*conns.AWSClient, error)
+
+type runnerOptions struct {
+	dryRun      bool
+	nameFilters []*regexp.Regexp
+	regions     []string
+	roleARNs    []string // An empty string represents the default credentials.
+}
+
+type runner struct {
+	sweepers map[string]*sweep.Sweeper
+	order    []string // The sweepers to run, in dependency order.
+	client   clientFunc
+	options  runnerOptions
+
+	mutex   sync.Mutex
+	results []result
+}
+
+// newRunner returns a runner for the sweepers whose names contain any of the specified filters, and their dependencies.
+// All sweepers are run if no filters are specified.
+// Returns an error if the dependency graph is incomplete or contains a cycle.
+func newRunner(sweepers map[string]*sweep.Sweeper, filters []string, client clientFunc, options runnerOptions) (*runner, error) {
+	g, err := sweep.NewDependencyGraph(sweepers)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := g.OverallOrder()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(filters) > 0 {
+		selected := make(map[string]struct{})
+		for name := range sweepers {
+			if !slices.ContainsFunc(filters, func(filter string) bool {
+				return strings.Contains(strings.ToLower(name), strings.ToLower(filter))
+			}) {
+				continue
+			}
+
+			dependencies, err := g.DependenciesOf(name)
+			if err != nil {
+				return nil, err
+			}
+
+			selected[name] = struct{}{}
+			for _, dependency := range dependencies {
+				selected[dependency] = struct{}{}
+			}
+		}
+
+		order = slices.DeleteFunc(order, func(name string) bool {
+			_, ok := selected[name]
+			return !ok
+		})
+	}
+
+	if len(options.roleARNs) == 0 {
+		options.roleARNs = []string{""}
+	}
+
+	return &runner{
+		sweepers: sweepers,
+		order:    order,
+		client:   client,
+		options:  options,
+	}, nil
+}
+
+// run runs the sweepers in dependency order, concurrently across all Regions and accounts.
+func (r *runner) run(ctx context.Context) *report {
+	var g tfsync.Group
+
+	for _, roleARN := range r.options.roleARNs {
+		for _, region := range r.options.regions {
+			g.Go(ctx, func(context.Context) error {
+				ctx := sweep.Context(region)
+
+				for _, name := range r.order {
+					r.runSweeper(ctx, r.sweepers[name], region, roleARN)
+				}
+				return nil
+			})
+		}
+	}
+
+	g.Wait(ctx) //nolint:errcheck // Errors are recorded as results.
+
+	report := &report{
+		DryRun: r.options.dryRun,
+		Results: slices.SortedStableFunc(slices.Values(r.results), func(a, b result) int {
+			return cmp.Or(
+				cmp.Compare(a.Account, b.Account),
+				cmp.Compare(a.Region, b.Region),
+				cmp.Compare(slices.Index(r.order, a.Sweeper), slices.Index(r.order, b.Sweeper)),
+				cmp.Compare(a.ID, b.ID),
+			)
+		}),
+	}
+	for _, result := range report.Results {
+		switch result.Status {
+		case resultStatusDeleted:
+			report.Deleted++
+		case resultStatusFailed:
+			report.Failed++
+		case resultStatusSkipped:
+			report.Skipped++
+		case resultStatusSwept:
+			report.Swept++
+		case resultStatusUnsupported:
+			report.Unsupported++
+		}
+	}
+
+	return report
+}
+
+func (r *runner) runSweeper(ctx context.Context, s *sweep.Sweeper, region, roleARN string) {
+	ctx = log.WithResourceType(ctx, s.Name)
+
+	template := result{
+		Account: roleARN,
+		Region:  region,
+		Sweeper: s.Name,
+	}
+
+	if s.List == nil {
+		r.runSweeperFunc(ctx, s, template)
+		return
+	}
+
+	client, err := r.client(ctx, region, roleARN)
+	if err != nil {
+		r.addResult(template, resultStatusFailed, fmt.Sprintf("getting client: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, "listing resources")
+	sweepables, err := s.List(ctx, client)
+
+	if awsv2.SkipSweepError(err) {
+		r.addResult(template, resultStatusSkipped, err.Error())
+		return
+	}
+	if err != nil {
+		r.addResult(template, resultStatusFailed, fmt.Sprintf("listing: %s", err))
+		return
+	}
+
+	var g tfsync.Group
+
+	for _, sweepable := range sweepables {
+		result := template
+		if v, ok := sweepable.(sweep.Identifiable); ok {
+			result.ID, result.Name = v.ID(), v.Name()
+		}
+
+		if reason, ok := r.skipResource(sweepable, result); ok {
+			r.addResult(result, resultStatusSkipped, reason)
+			continue
+		}
+
+		g.Go(ctx, func(ctx context.Context) error {
+			if err := sweepable.Delete(ctx); err != nil {
+				r.addResult(result, resultStatusFailed, err.Error())
+				return err
+			}
+
+			r.addResult(result, resultStatusDeleted, "")
+			return nil
+		})
+	}
+
+	g.Wait(ctx) //nolint:errcheck // Errors are recorded as results.
+}
+
+// runSweeperFunc runs a sweeper that doesn't support listing resources.
+// Such sweepers always use the default credentials and can't be dry run or filtered by resource name.
+func (r *runner) runSweeperFunc(ctx context.Context, s *sweep.Sweeper, template result) {
+	switch {
+	case r.options.dryRun:
+		r.addResult(template, resultStatusUnsupported, "sweeper does not support dry run")
+		return
+	case len(r.options.nameFilters) > 0:
+		r.addResult(template, resultStatusUnsupported, "sweeper does not support resource name filters")
+		return
+	case template.Account != "":
+		r.addResult(template, resultStatusUnsupported, "sweeper does not support assumed roles")
+		return
+	}
+
+	tflog.Info(ctx, "sweeping resources")
+	if err := s.F(template.Region); err != nil {
+		r.addResult(template, resultStatusFailed, err.Error())
+		return
+	}
+
+	r.addResult(template, resultStatusSwept, "")
+}
+
+// skipResource returns whether and why the specified resource should not be deleted.
+func (r *runner) skipResource(sweepable sweep.Sweepable, result result) (string, bool) {
+	if len(r.options.nameFilters) > 0 {
+		if _, ok := sweepable.(sweep.Identifiable); !ok {
+			return "resource name unknown", true
+		}
+
+		name := cmp.Or(result.Name, result.ID)
+		if !slices.ContainsFunc(r.options.nameFilters, func(re *regexp.Regexp) bool {
+			return re.MatchString(name)
+		}) {
+			return "resource name does not match filters", true
+		}
+	}
+
+	if r.options.dryRun {
+		return "dry run", true
+	}
+
+	return "", false
+}
+
+func (r *runner) addResult(result result, status resultStatus, reason string) {
+	result.Status = status
+	result.Reason = reason
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.results = append(r.results, result)
+}
@@ -0,0 +1,221 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const testRoleARN = "arn:aws:iam::123456789012:role/sweeper" //lintignore:AWSAT005
+
+type testSweepable struct {
+	id, name string
+	err      error
+}
+
+func (s testSweepable) ID() string {
+	return s.id
+}
+
+func (s testSweepable) Name() string {
+	return s.name
+}
+
+func (s testSweepable) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
+	return s.err
+}
+
+func testClient(context.Context, string, string) (*conns.AWSClient, error) {
+	return nil, nil
+}
+
+func testSweepers() map[string]*sweep.Sweeper {
+	return map[string]*sweep.Sweeper{
+		"aws_vpc": {
+			Name:         "aws_vpc",
+			Dependencies: []string{"aws_subnet"},
+			F:            func(string) error { return nil },
+		},
+		"aws_subnet": {
+			Name: "aws_subnet",
+			List: func(context.Context, *conns.AWSClient) ([]sweep.Sweepable, error) {
+				return []sweep.Sweepable{
+					testSweepable{id: "subnet-1", name: "tf-acc-test-1"},
+					testSweepable{id: "subnet-2", name: "example"},
+					testSweepable{id: "subnet-3", name: "tf-acc-test-3", err: errors.New("DependencyViolation")},
+				}, nil
+			},
+		},
+		"aws_instance": {
+			Name: "aws_instance",
+			List: func(context.Context, *conns.AWSClient) ([]sweep.Sweepable, error) {
+				return nil, nil
+			},
+		},
+	}
+}
+
+func TestNewRunner(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		sweepers      map[string]*sweep.Sweeper
+		filters       []string
+		expectedOrder []string
+		expectedError bool
+	}{
+		"all": {
+			sweepers:      testSweepers(),
+			expectedOrder: []string{"aws_instance", "aws_subnet", "aws_vpc"},
+		},
+		"filter with dependencies": {
+			sweepers:      testSweepers(),
+			filters:       []string{"VPC"},
+			expectedOrder: []string{"aws_subnet", "aws_vpc"},
+		},
+		"filter no match": {
+			sweepers:      testSweepers(),
+			filters:       []string{"aws_s3_bucket"},
+			expectedOrder: []string{},
+		},
+		"dependency cycle": {
+			sweepers: map[string]*sweep.Sweeper{
+				"aws_vpc":    {Name: "aws_vpc", Dependencies: []string{"aws_subnet"}},
+				"aws_subnet": {Name: "aws_subnet", Dependencies: []string{"aws_vpc"}},
+			},
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := newRunner(testCase.sweepers, testCase.filters, testClient, runnerOptions{})
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("newRunner() err = %v, want error %t", err, want)
+			}
+			if err != nil {
+				return
+			}
+
+			if got, want := r.order, testCase.expectedOrder; !slices.Equal(got, want) {
+				t.Errorf("order = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestRunnerRun(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		options         runnerOptions
+		expectedResults []result
+	}{
+		"sweep": {
+			options: runnerOptions{
+				regions: []string{"us-west-2"},
+			},
+			expectedResults: []result{
+				{Region: "us-west-2", Sweeper: "aws_subnet", ID: "subnet-1", Name: "tf-acc-test-1", Status: resultStatusDeleted},
+				{Region: "us-west-2", Sweeper: "aws_subnet", ID: "subnet-2", Name: "example", Status: resultStatusDeleted},
+				{Region: "us-west-2", Sweeper: "aws_subnet", ID: "subnet-3", Name: "tf-acc-test-3", Status: resultStatusFailed, Reason: "DependencyViolation"},
+				{Region: "us-west-2", Sweeper: "aws_vpc", Status: resultStatusSwept},
+			},
+		},
+		"dry run": {
+			options: runnerOptions{
+				dryRun:  true,
+				regions: []string{"us-west-2"},
+			},
+			expectedResults: []result{
+				{Region: "us-west-2", Sweeper: "aws_subnet", ID: "subnet-1", Name: "tf-acc-test-1", Status: resultStatusSkipped, Reason: "dry run"},
+				{Region: "us-west-2", Sweeper: "aws_subnet", ID: "subnet-2", Name: "example", Status: resultStatusSkipped, Reason: "dry run"},
+				{Region: "us-west-2", Sweeper: "aws_subnet", ID: "subnet-3", Name: "tf-acc-test-3", Status: resultStatusSkipped, Reason: "dry run"},
+				{Region: "us-west-2", Sweeper: "aws_vpc", Status: resultStatusUnsupported, Reason: "sweeper does not support dry run"},
+			},
+		},
+		"name filter": {
+			options: runnerOptions{
+				nameFilters: []*regexp.Regexp{regexache.MustCompile(`^example$`)},
+				regions:     []string{"us-west-2"},
+			},
+			expectedResults: []result{
+				{Region: "us-west-2", Sweeper: "aws_subnet", ID: "subnet-1", Name: "tf-acc-test-1", Status: resultStatusSkipped, Reason: "resource name does not match filters"},
+				{Region: "us-west-2", Sweeper: "aws_subnet", ID: "subnet-2", Name: "example", Status: resultStatusDeleted},
+				{Region: "us-west-2", Sweeper: "aws_subnet", ID: "subnet-3", Name: "tf-acc-test-3", Status: resultStatusSkipped, Reason: "resource name does not match filters"},
+				{Region: "us-west-2", Sweeper: "aws_vpc", Status: resultStatusUnsupported, Reason: "sweeper does not support resource name filters"},
+			},
+		},
+		"assumed roles": {
+			options: runnerOptions{
+				dryRun:   true,
+				regions:  []string{"us-east-1", "us-west-2"},
+				roleARNs: []string{testRoleARN},
+			},
+			expectedResults: []result{
+				{Account: testRoleARN, Region: "us-east-1", Sweeper: "aws_subnet", ID: "subnet-1", Name: "tf-acc-test-1", Status: resultStatusSkipped, Reason: "dry run"},
+				{Account: testRoleARN, Region: "us-east-1", Sweeper: "aws_subnet", ID: "subnet-2", Name: "example", Status: resultStatusSkipped, Reason: "dry run"},
+				{Account: testRoleARN, Region: "us-east-1", Sweeper: "aws_subnet", ID: "subnet-3", Name: "tf-acc-test-3", Status: resultStatusSkipped, Reason: "dry run"},
+				{Account: testRoleARN, Region: "us-east-1", Sweeper: "aws_vpc", Status: resultStatusUnsupported, Reason: "sweeper does not support dry run"},
+				{Account: testRoleARN, Region: "us-west-2", Sweeper: "aws_subnet", ID: "subnet-1", Name: "tf-acc-test-1", Status: resultStatusSkipped, Reason: "dry run"},
+				{Account: testRoleARN, Region: "us-west-2", Sweeper: "aws_subnet", ID: "subnet-2", Name: "example", Status: resultStatusSkipped, Reason: "dry run"},
+				{Account: testRoleARN, Region: "us-west-2", Sweeper: "aws_subnet", ID: "subnet-3", Name: "tf-acc-test-3", Status: resultStatusSkipped, Reason: "dry run"},
+				{Account: testRoleARN, Region: "us-west-2", Sweeper: "aws_vpc", Status: resultStatusUnsupported, Reason: "sweeper does not support dry run"},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := newRunner(testSweepers(), nil, testClient, testCase.options)
+			if err != nil {
+				t.Fatalf("newRunner() err = %s", err)
+			}
+
+			report := r.run(t.Context())
+
+			if got, want := report.Results, testCase.expectedResults; !slices.Equal(got, want) {
+				t.Errorf("results = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestRunnerRunCounts(t *testing.T) {
+	t.Parallel()
+
+	r, err := newRunner(testSweepers(), nil, testClient, runnerOptions{
+		regions: []string{"us-west-2"},
+	})
+	if err != nil {
+		t.Fatalf("newRunner() err = %s", err)
+	}
+
+	report := r.run(t.Context())
+
+	// Sweepers that don't support listing resources are counted as swept, not as deleted resources.
+	if got, want := report.Deleted, 2; got != want {
+		t.Errorf("Deleted = %d, want %d", got, want)
+	}
+	if got, want := report.Failed, 1; got != want {
+		t.Errorf("Failed = %d, want %d", got, want)
+	}
+	if got, want := report.Swept, 1; got != want {
+		t.Errorf("Swept = %d, want %d", got, want)
+	}
+}
@@ -5,6 +5,7 @@ package framework
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -46,6 +47,40 @@ func NewSweepResource(factory func(context.Context) (fwresource.ResourceWithConf
 	}
 }
 
+// ID returns the value of the id attribute or, if there is no id attribute, of the first attribute.
+func (sr *sweepResource) ID() string {
+	if v := sr.attributeString(names.AttrID); v != "" {
+		return v
+	}
+
+	if len(sr.attributes) > 0 {
+		return sr.attributeString(sr.attributes[0].path)
+	}
+
+	return ""
+}
+
+func (sr *sweepResource) Name() string {
+	return sr.attributeString(names.AttrName)
+}
+
+func (sr *sweepResource) attributeString(path string) string {
+	for _, attr := range sr.attributes {
+		if attr.path != path {
+			continue
+		}
+
+		switch v := attr.value.(type) {
+		case *string:
+			return aws.ToString(v)
+		default:
+			return fmt.Sprint(v)
+		}
+	}
+
+	return ""
+}
+
 func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
 	resource, err := sr.factory(ctx)
 	if err != nil {
@@ -0,0 +1,139 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package listresource
+
+import (
+	"cmp"
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/identity"
+	fwlistresource "github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/resourceattribute"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// FrameworkSweeper returns a sweeper function that lists resources using a Plugin Framework list resource
+// and deletes them using the corresponding Plugin Framework resource.
+func FrameworkSweeper(listSpec *inttypes.ServicePackageFrameworkListResource, resourceSpec *inttypes.ServicePackageFrameworkResource) sweep.SweeperFn {
+	return func(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+		r, err := resourceSpec.Factory(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := configure(ctx, r, client); err != nil {
+			return nil, err
+		}
+
+		var schemaResp resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+		if schemaResp.Diagnostics.HasError() {
+			return nil, fwdiag.DiagnosticsError(schemaResp.Diagnostics)
+		}
+
+		regionOverrideEnabled := isRegionOverrideEnabled(listSpec.Region)
+		if regionOverrideEnabled {
+			if _, ok := schemaResp.Schema.Attributes[names.AttrRegion]; !ok {
+				// Inject a top-level "region" attribute, as the provider does.
+				schemaResp.Schema.Attributes[names.AttrRegion] = resourceattribute.Region()
+			}
+		}
+
+		l := listSpec.Factory()
+
+		if v, ok := l.(framework.Identityer); ok {
+			v.SetIdentitySpec(listSpec.Identity)
+		}
+
+		if v, ok := l.(framework.Lister[fwlistresource.InterceptorParams]); ok {
+			if regionOverrideEnabled {
+				v.AppendResultInterceptor(fwlistresource.SetRegionInterceptor())
+			}
+
+			v.AppendResultInterceptor(fwlistresource.IdentityInterceptor(listSpec.Identity.Attributes))
+			v.AppendResultInterceptor(fwlistresource.DefaultObjectInterceptor())
+		}
+
+		if err := configure(ctx, l, client); err != nil {
+			return nil, err
+		}
+
+		request := list.ListRequest{
+			IncludeResource:        true,
+			ResourceSchema:         schemaResp.Schema,
+			ResourceIdentitySchema: identity.NewIdentitySchema(listSpec.Identity),
+		}
+
+		return listSweepables(ctx, l, request, func(result list.ListResult) (sweep.Sweepable, error) {
+			return newFrameworkSweepResource(r, result), nil
+		})
+	}
+}
+
+type frameworkSweepResource struct {
+	resource    resource.Resource
+	state       tfsdk.State
+	displayName string
+}
+
+func newFrameworkSweepResource(r resource.Resource, result list.ListResult) *frameworkSweepResource {
+	return &frameworkSweepResource{
+		resource: r,
+		state: tfsdk.State{
+			Raw:    result.Resource.Raw,
+			Schema: result.Resource.Schema,
+		},
+		displayName: result.DisplayName,
+	}
+}
+
+// ID returns the value of the id attribute or, if there is no id attribute, the list result's display name.
+func (sr *frameworkSweepResource) ID() string {
+	return cmp.Or(sr.attributeString(names.AttrID), sr.displayName)
+}
+
+func (sr *frameworkSweepResource) Name() string {
+	return sr.attributeString(names.AttrName)
+}
+
+func (sr *frameworkSweepResource) attributeString(name string) string {
+	var attributes map[string]tftypes.Value
+	if err := sr.state.Raw.As(&attributes); err != nil {
+		return ""
+	}
+
+	v, ok := attributes[name]
+	if !ok || !v.Type().Is(tftypes.String) {
+		return ""
+	}
+
+	var s *string
+	if err := v.As(&s); err != nil || s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+func (sr *frameworkSweepResource) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
+	ctx = tflog.SetField(ctx, "id", sr.ID())
+
+	tflog.Info(ctx, "Sweeping resource")
+
+	var response resource.DeleteResponse
+	sr.resource.Delete(ctx, resource.DeleteRequest{State: sr.state}, &response)
+
+	return fwdiag.DiagnosticsError(response.Diagnostics)
+}
@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package listresource
+
+import (
+	"cmp"
+	"context"
+	"strings"
+	"unique"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	tfunique "github.com/hashicorp/terraform-provider-aws/internal/unique"
+)
+
+type configurer interface {
+	Configure(context.Context, resource.ConfigureRequest, *resource.ConfigureResponse)
+}
+
+func configure(ctx context.Context, v configurer, client *conns.AWSClient) error {
+	var response resource.ConfigureResponse
+	v.Configure(ctx, resource.ConfigureRequest{ProviderData: client}, &response)
+
+	return fwdiag.DiagnosticsError(response.Diagnostics)
+}
+
+// listSweepables lists all resources using the specified list resource and converts each list result to a Sweepable.
+// The list request has a null configuration, so only resources created by acceptance tests are returned, as determined by isSweepable.
+// Any error listing resources is returned unmodified so that sweepers honor awsv2.SkipSweepError.
+func listSweepables(ctx context.Context, l list.ListResource, request list.ListRequest, f func(list.ListResult) (sweep.Sweepable, error)) ([]sweep.Sweepable, error) {
+	var stream list.ListResultsStream
+	l.List(ctx, request, &stream)
+
+	if stream.Results == nil {
+		return nil, nil
+	}
+
+	var sweepResources []sweep.Sweepable
+
+	for result := range stream.Results {
+		if result.Diagnostics.HasError() {
+			return nil, fwdiag.DiagnosticsError(result.Diagnostics)
+		}
+
+		v, err := f(result)
+		if err != nil {
+			return nil, err
+		}
+
+		if !isSweepable(v) {
+			continue
+		}
+
+		sweepResources = append(sweepResources, v)
+	}
+
+	return sweepResources, nil
+}
+
+// isSweepable returns whether the specified Sweepable was created by an acceptance test,
+// i.e. its name, or its ID if the name isn't known, begins with sweep.ResourcePrefix.
+func isSweepable(v sweep.Sweepable) bool {
+	identifiable, ok := v.(sweep.Identifiable)
+	if !ok {
+		return false
+	}
+
+	return strings.HasPrefix(cmp.Or(identifiable.Name(), identifiable.ID()), sweep.ResourcePrefix)
+}
+
+func isRegionOverrideEnabled(regionSpec unique.Handle[inttypes.ServicePackageResourceRegion]) bool {
+	return !tfunique.IsHandleNil(regionSpec) && regionSpec.Value().IsOverrideEnabled
+}
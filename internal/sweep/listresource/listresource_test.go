@@ -0,0 +1,281 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package listresource_test
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkdiag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/listresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const testTypeName = "aws_test_thing"
+
+type deletedIDs struct {
+	mutex sync.Mutex
+	ids   []string
+}
+
+func (d *deletedIDs) add(id string) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.ids = append(d.ids, id)
+}
+
+func (d *deletedIDs) sorted() []string {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	return slices.Sorted(slices.Values(d.ids))
+}
+
+type testSDKListResource struct {
+	framework.ListResourceWithSDKv2Resource
+	names []string
+	err   error
+}
+
+func (l *testSDKListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	stream.Results = func(yield func(list.ListResult) bool) {
+		if l.err != nil {
+			yield(fwdiag.NewListResultErrorDiagnostic(l.err))
+			return
+		}
+
+		for _, name := range l.names {
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId("id-" + name)
+			rd.Set(names.AttrName, name)
+
+			result.DisplayName = name
+
+			l.SetResult(ctx, l.Meta(), request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func testSDKResource(deleted *deletedIDs) func() *schema.Resource {
+	return func() *schema.Resource {
+		return &schema.Resource{
+			DeleteWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta any) sdkdiag.Diagnostics {
+				deleted.add(d.Id())
+
+				return nil
+			},
+			Schema: map[string]*schema.Schema{
+				names.AttrName: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		}
+	}
+}
+
+func testSDKSweeper(deleted *deletedIDs, resourceNames []string, err error) sweep.SweeperFn {
+	resourceSpec := &inttypes.ServicePackageSDKResource{
+		Factory:  testSDKResource(deleted),
+		TypeName: testTypeName,
+		Identity: inttypes.GlobalSingleParameterIdentity(names.AttrName),
+	}
+	listSpec := &inttypes.ServicePackageSDKListResource{
+		Factory: func() inttypes.ListResourceForSDK {
+			l := testSDKListResource{
+				names: resourceNames,
+				err:   err,
+			}
+			l.SetResourceSchema(resourceSpec.Factory())
+
+			return &l
+		},
+		TypeName: testTypeName,
+		Identity: resourceSpec.Identity,
+	}
+
+	return listresource.SDKSweeper(listSpec, resourceSpec)
+}
+
+type testFrameworkResourceModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+type testFrameworkResource struct {
+	framework.ResourceWithModel[testFrameworkResourceModel]
+	deleted *deletedIDs
+}
+
+func (r *testFrameworkResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = rschema.Schema{
+		Attributes: map[string]rschema.Attribute{
+			names.AttrID: rschema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: rschema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r *testFrameworkResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+}
+
+func (r *testFrameworkResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+}
+
+func (r *testFrameworkResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data testFrameworkResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	r.deleted.add(data.ID.ValueString())
+}
+
+type testFrameworkListResource struct {
+	testFrameworkResource
+	framework.WithList
+	names []string
+}
+
+func (l *testFrameworkListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for _, name := range l.names {
+			result := request.NewListResult(ctx)
+
+			var data testFrameworkResourceModel
+			l.SetResult(ctx, l.Meta(), &data, &result, func() {
+				data.ID = types.StringValue("id-" + name)
+				data.Name = types.StringValue(name)
+
+				result.DisplayName = name
+			})
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func testFrameworkSweeper(deleted *deletedIDs, resourceNames []string) sweep.SweeperFn {
+	resourceSpec := &inttypes.ServicePackageFrameworkResource{
+		Factory: func(context.Context) (resource.ResourceWithConfigure, error) {
+			return &testFrameworkResource{deleted: deleted}, nil
+		},
+		TypeName: testTypeName,
+		Identity: inttypes.GlobalSingleParameterIdentity(names.AttrName),
+	}
+	listSpec := &inttypes.ServicePackageFrameworkListResource{
+		Factory: func() list.ListResourceWithConfigure {
+			return &testFrameworkListResource{names: resourceNames}
+		},
+		TypeName: testTypeName,
+		Identity: resourceSpec.Identity,
+	}
+
+	return listresource.FrameworkSweeper(listSpec, resourceSpec)
+}
+
+func TestSweepers(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]func(*deletedIDs, []string) sweep.SweeperFn{
+		"SDK": func(deleted *deletedIDs, resourceNames []string) sweep.SweeperFn {
+			return testSDKSweeper(deleted, resourceNames, nil)
+		},
+		"Framework": testFrameworkSweeper,
+	}
+
+	for name, f := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			var deleted deletedIDs
+
+			sweepables, err := f(&deleted, []string{"tf-acc-test-one", "other", "tf-acc-test-two"})(ctx, &conns.AWSClient{})
+			if err != nil {
+				t.Fatalf("listing: %s", err)
+			}
+
+			var gotIDs, gotNames []string
+			for _, v := range sweepables {
+				identifiable, ok := v.(sweep.Identifiable)
+				if !ok {
+					t.Fatalf("%T does not implement sweep.Identifiable", v)
+				}
+				gotIDs = append(gotIDs, identifiable.ID())
+				gotNames = append(gotNames, identifiable.Name())
+			}
+
+			if got, want := gotIDs, []string{"id-tf-acc-test-one", "id-tf-acc-test-two"}; !slices.Equal(got, want) {
+				t.Errorf("IDs = %v, want %v", got, want)
+			}
+			if got, want := gotNames, []string{"tf-acc-test-one", "tf-acc-test-two"}; !slices.Equal(got, want) {
+				t.Errorf("Names = %v, want %v", got, want)
+			}
+
+			if err := sweep.SweepOrchestrator(ctx, sweepables); err != nil {
+				t.Fatalf("sweeping: %s", err)
+			}
+
+			if got, want := deleted.sorted(), []string{"id-tf-acc-test-one", "id-tf-acc-test-two"}; !slices.Equal(got, want) {
+				t.Errorf("deleted = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestSweeperSkipSweepError(t *testing.T) {
+	t.Parallel()
+
+	var deleted deletedIDs
+	listErr := &smithy.GenericAPIError{
+		Code:    "AccessDeniedException",
+		Message: "not authorized",
+	}
+
+	_, err := testSDKSweeper(&deleted, nil, listErr)(t.Context(), &conns.AWSClient{})
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+	if !awsv2.SkipSweepError(err) {
+		t.Errorf("SkipSweepError(%q) = false, want true", err)
+	}
+}
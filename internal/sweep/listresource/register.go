@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package listresource derives sweepers from list resources.
+// A list resource already knows how to enumerate all resources of its type, so
+// pairing it with the corresponding managed resource's Delete yields a sweeper.
+package listresource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// RegisterSweepers registers a sweeper for each list resource implemented by the specified service packages
+// that is opted in to derived sweepers with the `@Sweeper` annotation.
+// A derived sweeper deletes every listed resource whose name or ID begins with sweep.ResourcePrefix,
+// so only annotate list resources whose acceptance tests name resources that way and which
+// don't return resources managed by AWS or shared with other accounts.
+// List resources whose type already has a registered sweeper are skipped, so handwritten sweepers take precedence.
+// Must be called after all handwritten sweepers have been registered.
+func RegisterSweepers(ctx context.Context, servicePackages []conns.ServicePackage) {
+	registered := sweep.Sweepers()
+
+	for _, sp := range servicePackages {
+		if v, ok := sp.(conns.ServicePackageWithSDKListResources); ok {
+			resources := make(map[string]*inttypes.ServicePackageSDKResource)
+			for _, r := range sp.SDKResources(ctx) {
+				resources[r.TypeName] = r
+			}
+
+			for l := range v.SDKListResources(ctx) {
+				if l.Sweeper == nil {
+					continue
+				}
+				if _, ok := registered[l.TypeName]; ok {
+					continue
+				}
+
+				r, ok := resources[l.TypeName]
+				if !ok {
+					tflog.Warn(ctx, "No resource found for list resource", map[string]any{
+						"resource_type": l.TypeName,
+					})
+					continue
+				}
+
+				awsv2.Register(l.TypeName, SDKSweeper(l, r), l.Sweeper.Dependencies...)
+			}
+		}
+
+		if v, ok := sp.(conns.ServicePackageWithFrameworkListResources); ok {
+			resources := make(map[string]*inttypes.ServicePackageFrameworkResource)
+			for _, r := range sp.FrameworkResources(ctx) {
+				resources[r.TypeName] = r
+			}
+
+			for l := range v.FrameworkListResources(ctx) {
+				if l.Sweeper == nil {
+					continue
+				}
+				if _, ok := registered[l.TypeName]; ok {
+					continue
+				}
+
+				r, ok := resources[l.TypeName]
+				if !ok {
+					tflog.Warn(ctx, "No resource found for list resource", map[string]any{
+						"resource_type": l.TypeName,
+					})
+					continue
+				}
+
+				awsv2.Register(l.TypeName, FrameworkSweeper(l, r), l.Sweeper.Dependencies...)
+			}
+		}
+	}
+}
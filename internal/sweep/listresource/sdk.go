@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package listresource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/identity"
+	fwlistresource "github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/sdk"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// SDKSweeper returns a sweeper function that lists resources using a Plugin SDK list resource
+// and deletes them using the corresponding Plugin SDK resource.
+func SDKSweeper(listSpec *inttypes.ServicePackageSDKListResource, resourceSpec *inttypes.ServicePackageSDKResource) sweep.SweeperFn {
+	return func(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+		l := listSpec.Factory()
+
+		if v, ok := l.(framework.WithRegionSpec); ok {
+			v.SetRegionSpec(listSpec.Region)
+		}
+
+		if v, ok := l.(framework.Identityer); ok {
+			v.SetIdentitySpec(listSpec.Identity)
+		}
+
+		// List results only contain the resource's state as a Terraform value,
+		// so capture the underlying resource data as each result is set.
+		var d *schema.ResourceData
+		v, ok := l.(framework.Lister[fwlistresource.InterceptorParamsSDK])
+		if !ok {
+			return nil, fmt.Errorf("list resource (%s) does not support result interceptors", listSpec.TypeName)
+		}
+		v.AppendResultInterceptor(resourceDataInterceptor(func(rd *schema.ResourceData) {
+			d = rd
+		}))
+
+		if err := configure(ctx, l, client); err != nil {
+			return nil, err
+		}
+
+		request := list.ListRequest{
+			// The resource data is used directly, so the resource's state isn't included in list results.
+			ResourceSchema:         rschema.Schema{},
+			ResourceIdentitySchema: identity.NewIdentitySchema(listSpec.Identity),
+		}
+
+		return listSweepables(ctx, l, request, func(list.ListResult) (sweep.Sweepable, error) {
+			if d == nil {
+				return nil, fmt.Errorf("list resource (%s) returned a result without resource data", listSpec.TypeName)
+			}
+
+			sweepResource := sdk.NewSweepResource(resourceSpec.Factory(), d, client)
+			d = nil
+
+			return sweepResource, nil
+		})
+	}
+}
+
+type resourceDataInterceptor func(*schema.ResourceData)
+
+func (f resourceDataInterceptor) Read(ctx context.Context, params fwlistresource.InterceptorParamsSDK) diag.Diagnostics {
+	switch params.When {
+	case fwlistresource.After:
+		f(params.ResourceData)
+	}
+
+	return nil
+}
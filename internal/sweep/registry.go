@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+)
+
+// Sweeper is a registered sweeper.
+type Sweeper struct {
+	Name string
+
+	// Dependencies are the names of sweepers that must run before this sweeper.
+	Dependencies []string
+
+	// F sweeps all resources in the specified Region.
+	F func(region string) error
+
+	// List lists the resources to sweep.
+	// It is set only for sweepers registered via awsv2.Register and allows
+	// resources to be listed without being deleted.
+	List SweeperFn
+}
+
+var (
+	sweepers   = make(map[string]*Sweeper)
+	sweepersMu sync.Mutex
+)
+
+// Register registers a sweeper both for `go test -sweep` and for the standalone sweeper command.
+func Register(s *Sweeper) {
+	sweepersMu.Lock()
+	sweepers[s.Name] = s
+	sweepersMu.Unlock()
+
+	resource.AddTestSweepers(s.Name, &resource.Sweeper{
+		Name:         s.Name,
+		Dependencies: s.Dependencies,
+		F:            s.F,
+	})
+}
+
+// AddTestSweepers registers a sweeper implemented as a resource.Sweeper.
+// It is a drop-in replacement for resource.AddTestSweepers that makes the sweeper
+// available to the standalone sweeper command.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	Register(&Sweeper{
+		Name:         name,
+		Dependencies: s.Dependencies,
+		F:            s.F,
+	})
+}
+
+// Sweepers returns all registered sweepers, keyed by name.
+func Sweepers() map[string]*Sweeper {
+	sweepersMu.Lock()
+	defer sweepersMu.Unlock()
+
+	return maps.Clone(sweepers)
+}
+
+// NewDependencyGraph returns the dependency graph of the specified sweepers.
+// Each sweeper depends on the sweepers named in its Dependencies.
+// Returns an error if a sweeper depends on a sweeper that isn't present or if a dependency cycle is detected.
+func NewDependencyGraph(sweepers map[string]*Sweeper) (*depgraph.Graph, error) {
+	g := depgraph.New()
+
+	names := slices.Sorted(maps.Keys(sweepers))
+	for _, name := range names {
+		g.AddNode(name)
+	}
+
+	for _, name := range names {
+		for _, dependency := range sweepers[name].Dependencies {
+			if err := g.AddDependency(name, dependency); err != nil {
+				return nil, fmt.Errorf("sweeper (%s) has dependency (%s), but that sweeper was not found", name, dependency)
+			}
+		}
+	}
+
+	if _, err := g.OverallOrder(); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
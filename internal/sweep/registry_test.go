@@ -0,0 +1,81 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func TestNewDependencyGraph(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		sweepers      map[string]*sweep.Sweeper
+		expectedOrder []string
+		expectedError bool
+	}{
+		"no sweepers": {
+			sweepers:      map[string]*sweep.Sweeper{},
+			expectedOrder: []string{},
+		},
+		"dependencies": {
+			sweepers: map[string]*sweep.Sweeper{
+				"aws_vpc":      {Name: "aws_vpc", Dependencies: []string{"aws_subnet"}},
+				"aws_subnet":   {Name: "aws_subnet", Dependencies: []string{"aws_instance"}},
+				"aws_instance": {Name: "aws_instance"},
+			},
+			expectedOrder: []string{"aws_instance", "aws_subnet", "aws_vpc"},
+		},
+		"missing dependency": {
+			sweepers: map[string]*sweep.Sweeper{
+				"aws_vpc": {Name: "aws_vpc", Dependencies: []string{"aws_subnet"}},
+			},
+			expectedError: true,
+		},
+		"dependency cycle": {
+			sweepers: map[string]*sweep.Sweeper{
+				"aws_vpc":    {Name: "aws_vpc", Dependencies: []string{"aws_subnet"}},
+				"aws_subnet": {Name: "aws_subnet", Dependencies: []string{"aws_vpc"}},
+			},
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			g, err := sweep.NewDependencyGraph(testCase.sweepers)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("NewDependencyGraph() err = %v, want error %t", err, want)
+			}
+			if err != nil {
+				return
+			}
+
+			order, err := g.OverallOrder()
+			if err != nil {
+				t.Fatalf("OverallOrder() err = %s", err)
+			}
+
+			if got, want := order, testCase.expectedOrder; !slices.Equal(got, want) {
+				t.Errorf("OverallOrder() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+// TestRegisteredSweepersDependencyGraph verifies that every registered sweeper's dependencies are registered
+// and that there are no dependency cycles.
+func TestRegisteredSweepersDependencyGraph(t *testing.T) {
+	t.Parallel()
+
+	if _, err := sweep.NewDependencyGraph(sweep.Sweepers()); err != nil {
+		t.Fatal(err)
+	}
+}
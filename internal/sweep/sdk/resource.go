@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type sweepResource struct {
@@ -33,6 +34,20 @@ func newSweepResource(resource *schema.Resource, d *schema.ResourceData, meta *c
 	}
 }
 
+func (sr *sweepResource) ID() string {
+	return sr.d.Id()
+}
+
+func (sr *sweepResource) Name() string {
+	if _, ok := sr.resource.SchemaMap()[names.AttrName]; !ok {
+		return ""
+	}
+
+	v, _ := sr.d.Get(names.AttrName).(string)
+
+	return v
+}
+
 func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
 	ctx = tflog.SetField(ctx, "id", sr.d.Id())
 
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"sync"
 	"time"
 
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
@@ -27,13 +28,36 @@ const defaultSweeperAssumeRoleDurationSeconds = 3600
 // ServicePackages is set in TestMain in order to break an import cycle.
 var ServicePackages []conns.ServicePackage
 
+type sweeperClientKey struct {
+	region  string
+	roleARN string
+}
+
 // sweeperClients is a shared cache of regional conns.AWSClient
 // This prevents client re-initialization for every resource with no benefit.
-var sweeperClients map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
+var (
+	sweeperClients   map[sweeperClientKey]*conns.AWSClient = make(map[sweeperClientKey]*conns.AWSClient)
+	sweeperClientsMu sync.Mutex
+)
 
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper functions for a given Region.
 func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSClient, error) {
-	if client, ok := sweeperClients[region]; ok {
+	return SharedRegionalSweepClientWithAssumeRole(ctx, region, os.Getenv(envvar.AssumeRoleARN))
+}
+
+// SharedRegionalSweepClientWithAssumeRole returns a common conns.AWSClient setup needed for the sweeper functions
+// for a given Region, assuming the specified IAM role.
+// No role is assumed if roleARN is empty.
+func SharedRegionalSweepClientWithAssumeRole(ctx context.Context, region, roleARN string) (*conns.AWSClient, error) {
+	sweeperClientsMu.Lock()
+	defer sweeperClientsMu.Unlock()
+
+	key := sweeperClientKey{
+		region:  region,
+		roleARN: roleARN,
+	}
+
+	if client, ok := sweeperClients[key]; ok {
 		return client, nil
 	}
 
@@ -63,9 +87,9 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 		SuppressDebugLog: true,
 	}
 
-	if role := os.Getenv(envvar.AssumeRoleARN); role != "" {
+	if roleARN != "" {
 		ar := awsbase.AssumeRole{
-			RoleARN:  role,
+			RoleARN:  roleARN,
 			Duration: time.Duration(defaultSweeperAssumeRoleDurationSeconds) * time.Second,
 		}
 
@@ -95,7 +119,7 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 		return nil, fmt.Errorf("getting AWS client: %#v", diags)
 	}
 
-	sweeperClients[region] = client
+	sweeperClients[key] = client
 
 	return client, nil
 }
@@ -104,6 +128,14 @@ type Sweepable interface {
 	Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error
 }
 
+// Identifiable is implemented by Sweepables that can identify the resource to be swept.
+type Identifiable interface {
+	// ID returns the resource's identifier.
+	ID() string
+	// Name returns the resource's name, or an empty string if the resource's name isn't known.
+	Name() string
+}
+
 func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
 	if len(sweepables) == 0 {
 		tflog.Info(ctx, "No resources to sweep")
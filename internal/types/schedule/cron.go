@@ -0,0 +1,356 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	cronMinYear = 1970
+	cronMaxYear = 2199
+)
+
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names []string // Value names, indexed from min.
+}
+
+var (
+	cronSeconds    = cronField{name: "seconds", min: 0, max: 59}
+	cronMinutes    = cronField{name: "minutes", min: 0, max: 59}
+	cronHours      = cronField{name: "hours", min: 0, max: 23}
+	cronDayOfMonth = cronField{name: "day-of-month", min: 1, max: 31}
+	cronMonth      = cronField{name: "month", min: 1, max: 12, names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}}
+	cronDayOfWeek  = cronField{name: "day-of-week", min: 1, max: 7, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}}
+	cronYear       = cronField{name: "year", min: cronMinYear, max: cronMaxYear}
+)
+
+// cronExpression is a parsed cron expression.
+// Each set is indexed by field value.
+type cronExpression struct {
+	seconds []bool
+	minutes []bool
+	hours   []bool
+	months  []bool
+	years   []bool
+
+	dayOfMonthAny bool   // "?"
+	daysOfMonth   []bool // Plain values.
+	lastDay       bool   // "L"
+	lastWeekday   bool   // "LW"
+	nearestDay    int    // "nW"
+
+	dayOfWeekAny bool   // "?"
+	daysOfWeek   []bool // Plain values.
+	lastOfWeek   int    // "nL"
+	nthOfWeek    [2]int // "n#k"
+}
+
+func parseCron(s string) (*cronExpression, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 6 {
+		return nil, fmt.Errorf("cron expression must have 6 fields (minutes hours day-of-month month day-of-week year), got %d", len(fields))
+	}
+
+	return parseCronFields("0", fields)
+}
+
+// parseSSMCron parses an AWS Systems Manager cron expression, which has an optional leading seconds field.
+func parseSSMCron(s string) (*cronExpression, error) {
+	switch fields := strings.Fields(s); len(fields) {
+	case 6:
+		return parseCronFields("0", fields)
+	case 7:
+		return parseCronFields(fields[0], fields[1:])
+	default:
+		return nil, fmt.Errorf("cron expression must have 6 or 7 fields ([seconds] minutes hours day-of-month month day-of-week year), got %d", len(fields))
+	}
+}
+
+// parseCronFields parses the seconds field and the 6 other fields of a cron expression.
+func parseCronFields(seconds string, fields []string) (*cronExpression, error) {
+	var cron cronExpression
+	var errs []error
+	var err error
+
+	if cron.seconds, err = cronSeconds.parse(seconds); err != nil {
+		errs = append(errs, err)
+	}
+	if cron.minutes, err = cronMinutes.parse(fields[0]); err != nil {
+		errs = append(errs, err)
+	}
+	if cron.hours, err = cronHours.parse(fields[1]); err != nil {
+		errs = append(errs, err)
+	}
+	if err := cron.parseDayOfMonth(fields[2]); err != nil {
+		errs = append(errs, err)
+	}
+	if cron.months, err = cronMonth.parse(fields[3]); err != nil {
+		errs = append(errs, err)
+	}
+	if err := cron.parseDayOfWeek(fields[4]); err != nil {
+		errs = append(errs, err)
+	}
+	if cron.years, err = cronYear.parse(fields[5]); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	if cron.dayOfMonthAny == cron.dayOfWeekAny {
+		return nil, errors.New("exactly one of day-of-month or day-of-week must be ?")
+	}
+
+	return &cron, nil
+}
+
+func (cron *cronExpression) parseDayOfMonth(s string) error {
+	switch upper := strings.ToUpper(s); {
+	case upper == "?":
+		cron.dayOfMonthAny = true
+	case upper == "L":
+		cron.lastDay = true
+	case upper == "LW":
+		cron.lastWeekday = true
+	case strings.HasSuffix(upper, "W"):
+		v, err := cronDayOfMonth.value(strings.TrimSuffix(upper, "W"))
+		if err != nil {
+			return err
+		}
+		cron.nearestDay = v
+	default:
+		var err error
+		cron.daysOfMonth, err = cronDayOfMonth.parse(s)
+		return err
+	}
+
+	return nil
+}
+
+func (cron *cronExpression) parseDayOfWeek(s string) error {
+	switch upper := strings.ToUpper(s); {
+	case upper == "?":
+		cron.dayOfWeekAny = true
+	case upper == "L":
+		cron.lastOfWeek = cronDayOfWeek.max
+	case strings.HasSuffix(upper, "L"):
+		v, err := cronDayOfWeek.value(strings.TrimSuffix(upper, "L"))
+		if err != nil {
+			return err
+		}
+		cron.lastOfWeek = v
+	case strings.Contains(upper, "#"):
+		day, nth, _ := strings.Cut(upper, "#")
+		v, err := cronDayOfWeek.value(day)
+		if err != nil {
+			return err
+		}
+		n, err := strconv.Atoi(nth)
+		if err != nil || n < 1 || n > 5 {
+			return fmt.Errorf("%s: %q is not a valid occurrence, must be between 1 and 5", cronDayOfWeek.name, nth)
+		}
+		cron.nthOfWeek = [2]int{v, n}
+	default:
+		var err error
+		cron.daysOfWeek, err = cronDayOfWeek.parse(s)
+		return err
+	}
+
+	return nil
+}
+
+// parse parses a field consisting of a comma-separated list of values ("5"), ranges ("1-5"),
+// wildcards ("*") and increments ("*/5", "5/10" or "1-30/5").
+func (f cronField) parse(s string) ([]bool, error) {
+	set := make([]bool, f.max+1)
+
+	for item := range strings.SplitSeq(s, ",") {
+		rangeExpr, stepExpr, hasStep := strings.Cut(item, "/")
+
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepExpr)
+			if err != nil || step < 1 {
+				return nil, fmt.Errorf("%s: %q is not a valid increment", f.name, stepExpr)
+			}
+		}
+
+		var start, end int
+		switch lo, hi, isRange := strings.Cut(rangeExpr, "-"); {
+		case rangeExpr == "*":
+			start, end = f.min, f.max
+		case isRange:
+			var err error
+			if start, err = f.value(lo); err != nil {
+				return nil, err
+			}
+			if end, err = f.value(hi); err != nil {
+				return nil, err
+			}
+			if start > end {
+				return nil, fmt.Errorf("%s: %q is not a valid range", f.name, rangeExpr)
+			}
+		default:
+			var err error
+			if start, err = f.value(rangeExpr); err != nil {
+				return nil, err
+			}
+			end = start
+			if hasStep {
+				end = f.max
+			}
+		}
+
+		for v := start; v <= end; v += step {
+			set[v] = true
+		}
+	}
+
+	return set, nil
+}
+
+// value parses a single field value, which is either a number or a name.
+func (f cronField) value(s string) (int, error) {
+	for i, name := range f.names {
+		if strings.EqualFold(s, name) {
+			return f.min + i, nil
+		}
+	}
+
+	v, err := strconv.Atoi(s)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("%s: %q is not a valid value, must be between %d and %d", f.name, s, f.min, f.max)
+	}
+
+	return v, nil
+}
+
+// next returns the first time after the specified time that matches the cron expression.
+func (cron *cronExpression) next(after time.Time, loc *time.Location) (time.Time, bool) {
+	local := after.In(loc)
+
+	// Iterate over calendar days in UTC so that daylight saving time transitions don't affect day arithmetic.
+	day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+
+	for day.Year() <= cronMaxYear {
+		if !cron.years[day.Year()] {
+			day = time.Date(day.Year()+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+
+		if !cron.months[day.Month()] {
+			day = time.Date(day.Year(), day.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+
+		if cron.matchDay(day) {
+			for hour, ok := range cron.hours {
+				if !ok {
+					continue
+				}
+
+				for minute, ok := range cron.minutes {
+					if !ok {
+						continue
+					}
+
+					for second, ok := range cron.seconds {
+						if !ok {
+							continue
+						}
+
+						t := time.Date(day.Year(), day.Month(), day.Day(), hour, minute, second, 0, loc)
+
+						// Skip times that don't exist because of a daylight saving time transition.
+						if t.Hour() != hour || t.Minute() != minute {
+							continue
+						}
+
+						if t.After(after) {
+							return t, true
+						}
+					}
+				}
+			}
+		}
+
+		day = day.AddDate(0, 0, 1)
+	}
+
+	return time.Time{}, false
+}
+
+// matchDay returns whether the specified day (in UTC) matches the day-of-month and day-of-week fields.
+func (cron *cronExpression) matchDay(day time.Time) bool {
+	return cron.matchDayOfMonth(day) && cron.matchDayOfWeek(day)
+}
+
+func (cron *cronExpression) matchDayOfMonth(day time.Time) bool {
+	lastDay := daysIn(day)
+
+	switch {
+	case cron.dayOfMonthAny:
+		return true
+	case cron.lastDay:
+		return day.Day() == lastDay
+	case cron.lastWeekday:
+		return day.Day() == nearestWeekday(day, lastDay)
+	case cron.nearestDay > 0:
+		return cron.nearestDay <= lastDay && day.Day() == nearestWeekday(day, cron.nearestDay)
+	default:
+		return cron.daysOfMonth[day.Day()]
+	}
+}
+
+func (cron *cronExpression) matchDayOfWeek(day time.Time) bool {
+	// Day-of-week values are 1 (Sunday) to 7 (Saturday).
+	dayOfWeek := int(day.Weekday()) + 1
+
+	switch {
+	case cron.dayOfWeekAny:
+		return true
+	case cron.lastOfWeek > 0:
+		return dayOfWeek == cron.lastOfWeek && day.Day()+7 > daysIn(day)
+	case cron.nthOfWeek[0] > 0:
+		return dayOfWeek == cron.nthOfWeek[0] && (day.Day()-1)/7+1 == cron.nthOfWeek[1]
+	default:
+		return cron.daysOfWeek[dayOfWeek]
+	}
+}
+
+// daysIn returns the number of days in the specified day's month.
+func daysIn(day time.Time) int {
+	return time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// nearestWeekday returns the weekday (Monday to Friday) in the specified day's month that is nearest to the specified day of the month.
+// The result is never in a different month.
+func nearestWeekday(day time.Time, dayOfMonth int) int {
+	lastDay := daysIn(day)
+
+	switch time.Date(day.Year(), day.Month(), dayOfMonth, 0, 0, 0, 0, time.UTC).Weekday() {
+	case time.Saturday:
+		if dayOfMonth == 1 {
+			return dayOfMonth + 2
+		}
+		return dayOfMonth - 1
+	case time.Sunday:
+		if dayOfMonth == lastDay {
+			return dayOfMonth - 2
+		}
+		return dayOfMonth + 1
+	default:
+		return dayOfMonth
+	}
+}
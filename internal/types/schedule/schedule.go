@@ -0,0 +1,170 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Kind is the kind of a schedule expression.
+type Kind string
+
+const (
+	KindAt   Kind = "at"
+	KindCron Kind = "cron"
+	KindRate Kind = "rate"
+)
+
+const (
+	atLayout = "2006-01-02T15:04:05"
+)
+
+// Expression is a parsed schedule expression, as accepted by Amazon EventBridge, EventBridge Scheduler,
+// AWS Backup, AWS Glue and other services:
+//
+//   - at(yyyy-mm-ddThh:mm:ss)
+//   - cron(minutes hours day-of-month month day-of-week year)
+//   - rate(value unit)
+//
+// See https://docs.aws.amazon.com/scheduler/latest/UserGuide/schedule-types.html.
+type Expression struct {
+	kind Kind
+	at   time.Time // Wall clock time, in UTC.
+	cron *cronExpression
+	rate time.Duration
+}
+
+// Parse parses a schedule expression.
+func Parse(s string) (Expression, error) {
+	return parse(s, parseCron)
+}
+
+// ParseSSM parses a schedule expression as accepted by AWS Systems Manager maintenance windows and State Manager associations.
+// Systems Manager cron expressions have an optional leading seconds field:
+//
+//   - cron([seconds] minutes hours day-of-month month day-of-week year)
+//
+// See https://docs.aws.amazon.com/systems-manager/latest/userguide/reference-cron-and-rate-expressions.html.
+func ParseSSM(s string) (Expression, error) {
+	return parse(s, parseSSMCron)
+}
+
+func parse(s string, parseCron func(string) (*cronExpression, error)) (Expression, error) {
+	kind, body, ok := strings.Cut(strings.TrimSpace(s), "(")
+	if !ok || !strings.HasSuffix(body, ")") {
+		return Expression{}, fmt.Errorf("%q is not a valid schedule expression: must be at(...), cron(...) or rate(...)", s)
+	}
+	body = strings.TrimSpace(strings.TrimSuffix(body, ")"))
+
+	var expression Expression
+	var err error
+
+	switch kind := Kind(kind); kind {
+	case KindAt:
+		expression.at, err = time.Parse(atLayout, body)
+		if err != nil {
+			err = fmt.Errorf("timestamp must be in the format yyyy-mm-ddThh:mm:ss")
+		}
+	case KindCron:
+		expression.cron, err = parseCron(body)
+	case KindRate:
+		expression.rate, err = parseRate(body)
+	default:
+		err = fmt.Errorf("must be at(...), cron(...) or rate(...)")
+	}
+
+	if err != nil {
+		return Expression{}, fmt.Errorf("%q is not a valid schedule expression: %w", s, err)
+	}
+
+	expression.kind = Kind(kind)
+
+	return expression, nil
+}
+
+// Kind returns the kind of the schedule expression.
+func (e Expression) Kind() Kind {
+	return e.kind
+}
+
+// Next returns the first time after the specified time at which the schedule fires,
+// evaluating the schedule in the specified location.
+// Rate schedules fire at multiples of their interval after the specified time.
+// Returns false if the schedule never fires after the specified time.
+func (e Expression) Next(after time.Time, loc *time.Location) (time.Time, bool) {
+	switch e.kind {
+	case KindAt:
+		t := time.Date(e.at.Year(), e.at.Month(), e.at.Day(), e.at.Hour(), e.at.Minute(), e.at.Second(), 0, loc)
+		if t.After(after) {
+			return t, true
+		}
+	case KindCron:
+		return e.cron.next(after, loc)
+	case KindRate:
+		return after.Add(e.rate).In(loc), true
+	}
+
+	return time.Time{}, false
+}
+
+// Validate returns an error if the specified string is not a valid schedule expression of one of the specified kinds.
+// If no kinds are specified, all kinds are valid.
+func Validate(s string, kinds ...Kind) error {
+	return validate(Parse, s, kinds)
+}
+
+// ValidateSSM returns an error if the specified string is not a valid AWS Systems Manager schedule expression of one of the specified kinds.
+// If no kinds are specified, all kinds are valid.
+func ValidateSSM(s string, kinds ...Kind) error {
+	return validate(ParseSSM, s, kinds)
+}
+
+func validate(parse func(string) (Expression, error), s string, kinds []Kind) error {
+	expression, err := parse(s)
+	if err != nil {
+		return err
+	}
+
+	if len(kinds) > 0 && !slices.Contains(kinds, expression.Kind()) {
+		var valid []string
+		for _, kind := range kinds {
+			valid = append(valid, string(kind)+"(...)")
+		}
+
+		return fmt.Errorf("%q is not a valid schedule expression: must be %s", s, strings.Join(valid, " or "))
+	}
+
+	return nil
+}
+
+func parseRate(s string) (time.Duration, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return 0, errors.New("must be rate(value unit)")
+	}
+
+	value, err := strconv.Atoi(fields[0])
+	if err != nil || value < 1 {
+		return 0, errors.New("value must be a positive integer")
+	}
+
+	var unit time.Duration
+	switch fields[1] {
+	case "minute", "minutes":
+		unit = time.Minute
+	case "hour", "hours":
+		unit = time.Hour
+	case "day", "days":
+		unit = 24 * time.Hour
+	default:
+		return 0, errors.New("unit must be minute(s), hour(s) or day(s)")
+	}
+
+	return time.Duration(value) * unit, nil
+}
@@ -0,0 +1,373 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		input       string
+		kinds       []Kind
+		expectedErr bool
+	}{
+		"empty": {
+			input:       "",
+			expectedErr: true,
+		},
+		"unknown kind": {
+			input:       "every(5 minutes)",
+			expectedErr: true,
+		},
+		"at": {
+			input: "at(2026-11-20T13:00:00)",
+		},
+		"at invalid": {
+			input:       "at(2026-11-20 13:00)",
+			expectedErr: true,
+		},
+		"rate": {
+			input: "rate(5 minutes)",
+		},
+		"rate singular": {
+			input: "rate(1 hour)",
+		},
+		"rate plural": {
+			input: "rate(1 hours)",
+		},
+		"rate zero": {
+			input:       "rate(0 days)",
+			expectedErr: true,
+		},
+		"rate invalid unit": {
+			input:       "rate(5 weeks)",
+			expectedErr: true,
+		},
+		"cron": {
+			input: "cron(0/15 8-17 ? * MON-FRI *)",
+		},
+		"cron names lowercase": {
+			input: "cron(0 12 ? jan,jul sun 2026-2030)",
+		},
+		"cron last day": {
+			input: "cron(0 0 L * ? *)",
+		},
+		"cron nearest weekday": {
+			input: "cron(0 0 15W * ? *)",
+		},
+		"cron nth day of week": {
+			input: "cron(0 2 ? * THU#3 *)",
+		},
+		"cron last day of week": {
+			input: "cron(0 2 ? * 6L *)",
+		},
+		"cron 5 fields": {
+			input:       "cron(0 12 * * ?)",
+			expectedErr: true,
+		},
+		"cron both days": {
+			input:       "cron(0 12 * * MON *)",
+			expectedErr: true,
+		},
+		"cron neither day": {
+			input:       "cron(0 12 ? * ? *)",
+			expectedErr: true,
+		},
+		"cron minutes out of range": {
+			input:       "cron(60 12 * * ? *)",
+			expectedErr: true,
+		},
+		"cron invalid range": {
+			input:       "cron(0 17-8 * * ? *)",
+			expectedErr: true,
+		},
+		"cron invalid occurrence": {
+			input:       "cron(0 2 ? * THU#6 *)",
+			expectedErr: true,
+		},
+		"kind allowed": {
+			input: "rate(5 minutes)",
+			kinds: []Kind{KindCron, KindRate},
+		},
+		"kind not allowed": {
+			input:       "at(2026-11-20T13:00:00)",
+			kinds:       []Kind{KindCron, KindRate},
+			expectedErr: true,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := Validate(testcase.input, testcase.kinds...)
+
+			if got, want := err != nil, testcase.expectedErr; got != want {
+				t.Errorf("Validate(%q) err = %v, want error %t", testcase.input, err, want)
+			}
+		})
+	}
+}
+
+func TestValidateSSM(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		input       string
+		kinds       []Kind
+		expectedErr bool
+	}{
+		"at": {
+			input: "at(2026-11-20T13:00:00)",
+		},
+		"rate": {
+			input: "rate(60 minutes)",
+		},
+		"cron": {
+			input: "cron(0 16 ? * TUE *)",
+		},
+		"cron any day of week": {
+			input: "cron(0 6 ? * * *)",
+		},
+		"cron seconds": {
+			input: "cron(0 30 23 ? * TUE#3 *)",
+		},
+		"cron seconds increment": {
+			input: "cron(0 0/30 * 1/1 * ? *)",
+		},
+		"cron 5 fields": {
+			input:       "cron(0 16 ? * TUE)",
+			expectedErr: true,
+		},
+		"cron 8 fields": {
+			input:       "cron(0 0 16 ? * TUE * *)",
+			expectedErr: true,
+		},
+		"cron seconds out of range": {
+			input:       "cron(60 30 23 ? * TUE *)",
+			expectedErr: true,
+		},
+		"cron both days": {
+			input:       "cron(0 30 23 * * TUE *)",
+			expectedErr: true,
+		},
+		"kind not allowed": {
+			input:       "at(2026-11-20T13:00:00)",
+			kinds:       []Kind{KindCron, KindRate},
+			expectedErr: true,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateSSM(testcase.input, testcase.kinds...)
+
+			if got, want := err != nil, testcase.expectedErr; got != want {
+				t.Errorf("ValidateSSM(%q) err = %v, want error %t", testcase.input, err, want)
+			}
+		})
+	}
+}
+
+func TestExpressionNext(t *testing.T) {
+	t.Parallel()
+
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("loading location: %s", err)
+	}
+
+	after := time.Date(2026, time.October, 18, 14, 7, 30, 0, time.UTC) // Sunday.
+
+	testcases := map[string]struct {
+		input    string
+		loc      *time.Location
+		after    time.Time
+		expected []time.Time
+	}{
+		"at": {
+			input:    "at(2026-11-20T13:00:00)",
+			loc:      time.UTC,
+			after:    after,
+			expected: []time.Time{time.Date(2026, time.November, 20, 13, 0, 0, 0, time.UTC)},
+		},
+		"at past": {
+			input: "at(2025-11-20T13:00:00)",
+			loc:   time.UTC,
+			after: after,
+		},
+		"rate": {
+			input: "rate(2 hours)",
+			loc:   time.UTC,
+			after: after,
+			expected: []time.Time{
+				time.Date(2026, time.October, 18, 16, 7, 30, 0, time.UTC),
+				time.Date(2026, time.October, 18, 18, 7, 30, 0, time.UTC),
+			},
+		},
+		"cron every 15 minutes": {
+			input: "cron(0/15 * * * ? *)",
+			loc:   time.UTC,
+			after: after,
+			expected: []time.Time{
+				time.Date(2026, time.October, 18, 14, 15, 0, 0, time.UTC),
+				time.Date(2026, time.October, 18, 14, 30, 0, 0, time.UTC),
+				time.Date(2026, time.October, 18, 14, 45, 0, 0, time.UTC),
+			},
+		},
+		"cron weekdays": {
+			input: "cron(0 9 ? * MON-FRI *)",
+			loc:   newYork,
+			after: after,
+			expected: []time.Time{
+				time.Date(2026, time.October, 19, 9, 0, 0, 0, newYork),
+				time.Date(2026, time.October, 20, 9, 0, 0, 0, newYork),
+			},
+		},
+		"cron last day": {
+			input: "cron(30 23 L * ? *)",
+			loc:   time.UTC,
+			after: after,
+			expected: []time.Time{
+				time.Date(2026, time.October, 31, 23, 30, 0, 0, time.UTC),
+				time.Date(2026, time.November, 30, 23, 30, 0, 0, time.UTC),
+				time.Date(2026, time.December, 31, 23, 30, 0, 0, time.UTC),
+			},
+		},
+		"cron last weekday": {
+			input: "cron(0 0 LW * ? *)",
+			loc:   time.UTC,
+			after: after,
+			expected: []time.Time{
+				time.Date(2026, time.October, 30, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, time.November, 30, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		"cron nearest weekday": {
+			input: "cron(0 0 1W * ? *)",
+			loc:   time.UTC,
+			after: after,
+			expected: []time.Time{
+				time.Date(2026, time.November, 2, 0, 0, 0, 0, time.UTC), // November 1 is a Sunday.
+				time.Date(2026, time.December, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		"cron third Thursday": {
+			input: "cron(0 2 ? * THU#3 *)",
+			loc:   time.UTC,
+			after: after,
+			expected: []time.Time{
+				time.Date(2026, time.November, 19, 2, 0, 0, 0, time.UTC),
+				time.Date(2026, time.December, 17, 2, 0, 0, 0, time.UTC),
+			},
+		},
+		"cron last Friday": {
+			input: "cron(0 2 ? * 6L *)",
+			loc:   time.UTC,
+			after: after,
+			expected: []time.Time{
+				time.Date(2026, time.October, 30, 2, 0, 0, 0, time.UTC),
+				time.Date(2026, time.November, 27, 2, 0, 0, 0, time.UTC),
+			},
+		},
+		"cron daylight saving time gap": {
+			input: "cron(30 2 * 3 ? 2027)",
+			loc:   newYork,
+			after: time.Date(2027, time.March, 13, 12, 0, 0, 0, newYork),
+			expected: []time.Time{
+				time.Date(2027, time.March, 13, 2, 30, 0, 0, newYork).AddDate(0, 0, 2), // 2:30 doesn't exist on March 14.
+			},
+		},
+		"cron year in past": {
+			input: "cron(0 0 * * ? 2020)",
+			loc:   time.UTC,
+			after: after,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			expression, err := Parse(testcase.input)
+			if err != nil {
+				t.Fatalf("Parse(%q) err = %s", testcase.input, err)
+			}
+
+			t0 := testcase.after
+			for i, want := range testcase.expected {
+				got, ok := expression.Next(t0, testcase.loc)
+				if !ok {
+					t.Fatalf("Next %d: no next time, want %s", i, want)
+				}
+				if !got.Equal(want) {
+					t.Errorf("Next %d = %s, want %s", i, got, want)
+				}
+				t0 = got
+			}
+
+			if len(testcase.expected) == 0 {
+				if got, ok := expression.Next(t0, testcase.loc); ok {
+					t.Errorf("Next = %s, want none", got)
+				}
+			}
+		})
+	}
+}
+
+func TestExpressionNextSSM(t *testing.T) {
+	t.Parallel()
+
+	after := time.Date(2026, time.October, 18, 14, 7, 30, 0, time.UTC) // Sunday.
+
+	testcases := map[string]struct {
+		input    string
+		expected []time.Time
+	}{
+		"cron": {
+			input: "cron(0 16 ? * TUE *)",
+			expected: []time.Time{
+				time.Date(2026, time.October, 20, 16, 0, 0, 0, time.UTC),
+				time.Date(2026, time.October, 27, 16, 0, 0, 0, time.UTC),
+			},
+		},
+		"cron seconds": {
+			input: "cron(15,45 8 14 ? * SUN *)",
+			expected: []time.Time{
+				time.Date(2026, time.October, 18, 14, 8, 15, 0, time.UTC),
+				time.Date(2026, time.October, 18, 14, 8, 45, 0, time.UTC),
+				time.Date(2026, time.October, 25, 14, 8, 15, 0, time.UTC),
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			expression, err := ParseSSM(testcase.input)
+			if err != nil {
+				t.Fatalf("ParseSSM(%q) err = %s", testcase.input, err)
+			}
+
+			t0 := after
+			for i, want := range testcase.expected {
+				got, ok := expression.Next(t0, time.UTC)
+				if !ok {
+					t.Fatalf("Next %d: no next time, want %s", i, want)
+				}
+				if !got.Equal(want) {
+					t.Errorf("Next %d = %s, want %s", i, got, want)
+				}
+				t0 = got
+			}
+		})
+	}
+}
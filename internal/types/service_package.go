@@ -91,6 +91,7 @@ type ServicePackageFrameworkListResource struct {
 	Tags     unique.Handle[ServicePackageResourceTags]
 	Region   unique.Handle[ServicePackageResourceRegion]
 	Identity Identity
+	Sweeper  *ListResourceSweeper
 }
 
 // ServicePackageSDKDataSource represents a Terraform Plugin SDK data source
@@ -127,6 +128,12 @@ type ServicePackageSDKListResource struct {
 	Tags     unique.Handle[ServicePackageResourceTags]
 	Region   unique.Handle[ServicePackageResourceRegion]
 	Identity Identity
+	Sweeper  *ListResourceSweeper
+}
+
+// ListResourceSweeper opts a list resource in to a sweeper derived from it.
+type ListResourceSweeper struct {
+	Dependencies []string // Resource types that must be swept first
 }
 
 type Identity struct {
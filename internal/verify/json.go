@@ -12,10 +12,10 @@ import (
 	"strings"
 
 	"github.com/YakDriver/regexache"
-	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
 )
 
 // SuppressEquivalentPolicyDiffs returns a difference suppression function that compares
@@ -24,24 +24,10 @@ func SuppressEquivalentPolicyDiffs(k, old, new string, d *schema.ResourceData) b
 	return PolicyStringsEquivalent(old, new)
 }
 
+// PolicyStringsEquivalent returns whether two JSON strings representing IAM policies are semantically equivalent.
+// Empty strings (`""`) and empty JSON strings (`"{}"`) are equivalent.
 func PolicyStringsEquivalent(s1, s2 string) bool {
-	if strings.TrimSpace(s1) == "" && strings.TrimSpace(s2) == "" {
-		return true
-	}
-
-	if strings.TrimSpace(s1) == "{}" && strings.TrimSpace(s2) == "" {
-		return true
-	}
-
-	if strings.TrimSpace(s1) == "" && strings.TrimSpace(s2) == "{}" {
-		return true
-	}
-
-	if strings.TrimSpace(s1) == "{}" && strings.TrimSpace(s2) == "{}" {
-		return true
-	}
-
-	equivalent, err := awspolicy.PoliciesAreEquivalent(s1, s2)
+	equivalent, err := iampolicy.Equivalent(s1, s2)
 	if err != nil {
 		return false
 	}
@@ -147,7 +133,7 @@ func SecondJSONUnlessEquivalent(old, new string) (string, error) {
 		return new, nil
 	}
 
-	equivalent, err := awspolicy.PoliciesAreEquivalent(old, new)
+	equivalent, err := iampolicy.Equivalent(old, new)
 
 	if err != nil {
 		// Plugin SDK V2 based resources can set malformed policy content in state
@@ -172,13 +158,18 @@ func SecondJSONUnlessEquivalent(old, new string) (string, error) {
 }
 
 // PolicyToSet returns the existing policy if the new policy is equivalent.
-// Otherwise, it returns the new policy. Either policy is normalized.
+// Otherwise, it returns the new policy. Either policy is canonicalized (see iampolicy.Canonicalize).
 func PolicyToSet(exist, new string) (string, error) {
 	policyToSet, err := SecondJSONUnlessEquivalent(exist, new)
 	if err != nil {
 		return "", fmt.Errorf("while checking equivalency of existing policy (%s) and new policy (%s), encountered: %w", exist, new, err)
 	}
 
+	if v, err := iampolicy.Canonicalize(policyToSet); err == nil {
+		return v, nil
+	}
+
+	// Equivalent documents that aren't valid IAM policies are normalized as JSON.
 	policyToSet, err = structure.NormalizeJsonString(policyToSet)
 	if err != nil {
 		return "", fmt.Errorf("policy (%s) is invalid JSON: %w", policyToSet, err)
@@ -411,6 +411,65 @@ func TestSecondJSONUnlessEquivalent(t *testing.T) {
 	}
 }
 
+func TestPolicyToSet(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		exist     string
+		new       string
+		want      string
+		wantError bool
+	}{
+		{
+			name:  "equivalent",
+			exist: `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": ["s3:PutObject", "s3:GetObject"], "Resource": "*"}]}`,
+			new:   `{"Statement": {"Resource": ["*"], "Action": ["S3:GetObject", "s3:putobject"], "Effect": "Allow"}, "Version": "2012-10-17"}`,
+			want:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":"*"}]}`,
+		},
+		{
+			name:  "actual change",
+			exist: `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}]}`,
+			new:   `{"Statement": [{"Resource": ["*"], "Action": ["s3:PutObject", "s3:GetObject"], "Effect": "Allow"}], "Version": "2012-10-17"}`,
+			want:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":"*"}]}`,
+		},
+		{
+			name:  "empty new",
+			exist: `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}]}`,
+			new:   "",
+			want:  "",
+		},
+		{
+			name:  "identical invalid policies",
+			exist: `{"Statement": {"Resource": {"b": 1, "a": 2}}}`,
+			new:   `{"Statement":{"Resource":{"a":2,"b":1}}}`,
+			want:  `{"Statement":{"Resource":{"a":2,"b":1}}}`,
+		},
+		{
+			name:      "invalid new",
+			exist:     `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}]}`,
+			new:       `{"Statement": {"Resource": {"a": "b"}}}`,
+			wantError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := PolicyToSet(testCase.exist, testCase.new)
+
+			if got, want := err != nil, testCase.wantError; got != want {
+				t.Fatalf("PolicyToSet err %t, want %t: %v", got, want, err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("PolicyToSet = %s, want %s", got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestNormalizeJSONOrYAMLString(t *testing.T) {
 	t.Parallel()
 
@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/timestamp"
 )
 
@@ -445,6 +446,38 @@ func StringHasPrefix(prefix string) schema.SchemaValidateFunc {
 	}
 }
 
+// ValidScheduleExpression returns a SchemaValidateFunc which tests if the provided value
+// is a valid at(), cron() or rate() schedule expression of one of the specified kinds.
+func ValidScheduleExpression(kinds ...schedule.Kind) schema.SchemaValidateFunc {
+	return validScheduleExpression(schedule.Validate, kinds)
+}
+
+// ValidSSMScheduleExpression returns a SchemaValidateFunc which tests if the provided value
+// is a valid AWS Systems Manager at(), cron() or rate() schedule expression of one of the specified kinds.
+func ValidSSMScheduleExpression(kinds ...schedule.Kind) schema.SchemaValidateFunc {
+	return validScheduleExpression(schedule.ValidateSSM, kinds)
+}
+
+func validScheduleExpression(validate func(string, ...schedule.Kind) error, kinds []schedule.Kind) schema.SchemaValidateFunc {
+	return func(v any, k string) (ws []string, errors []error) {
+		value, ok := v.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if value == "" {
+			return
+		}
+
+		if err := validate(value, kinds...); err != nil {
+			errors = append(errors, fmt.Errorf("%s: %w", k, err))
+		}
+
+		return
+	}
+}
+
 func ValidServicePrincipal(v any, k string) (ws []string, errors []error) {
 	value := v.(string)
 
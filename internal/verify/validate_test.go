@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
 )
 
 func TestValidAmazonSideASN(t *testing.T) {
@@ -598,6 +599,69 @@ func TestValidUTCTimestamp(t *testing.T) {
 	}
 }
 
+func TestValidScheduleExpression(t *testing.T) {
+	t.Parallel()
+
+	validT := []string{
+		"",
+		"cron(0 12 ? * MON-FRI *)",
+		"rate(1 day)",
+	}
+
+	invalidT := []string{
+		"cron(0 12 * * ?)",
+		"cron(0 12 * * MON *)",
+		"rate(1 weeks)",
+		"at(2026-11-20T13:00:00)",
+	}
+
+	for _, f := range validT {
+		_, errors := ValidScheduleExpression(schedule.KindCron, schedule.KindRate)(f, "schedule_expression")
+		if len(errors) > 0 {
+			t.Fatalf("expected the schedule expression %q to be valid, got error %q", f, errors)
+		}
+	}
+
+	for _, f := range invalidT {
+		_, errors := ValidScheduleExpression(schedule.KindCron, schedule.KindRate)(f, "schedule_expression")
+		if len(errors) == 0 {
+			t.Fatalf("expected the schedule expression %q to fail validation", f)
+		}
+	}
+}
+
+func TestValidSSMScheduleExpression(t *testing.T) {
+	t.Parallel()
+
+	validT := []string{
+		"",
+		"cron(0 16 ? * TUE *)",
+		"cron(0 30 23 ? * TUE#3 *)",
+		"rate(60 minutes)",
+		"at(2026-11-20T13:00:00)",
+	}
+
+	invalidT := []string{
+		"cron(0 16 ? * TUE)",
+		"cron(60 30 23 ? * TUE *)",
+		"rate(1 weeks)",
+	}
+
+	for _, f := range validT {
+		_, errors := ValidSSMScheduleExpression()(f, "schedule")
+		if len(errors) > 0 {
+			t.Fatalf("expected the schedule expression %q to be valid, got error %q", f, errors)
+		}
+	}
+
+	for _, f := range invalidT {
+		_, errors := ValidSSMScheduleExpression()(f, "schedule")
+		if len(errors) == 0 {
+			t.Fatalf("expected the schedule expression %q to fail validation", f)
+		}
+	}
+}
+
 func TestValidateTypeStringIsDateOrInt(t *testing.T) {
 	t.Parallel()
 
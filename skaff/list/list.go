@@ -34,6 +34,12 @@ var lisTestConfigTmpl string
 //go:embed query.gtpl
 var queryTmpl string
 
+//go:embed testconfig_region_override.gtpl
+var listTestConfigRegionOverrideTmpl string
+
+//go:embed query_region_override.gtpl
+var queryRegionOverrideTmpl string
+
 //go:embed websitedoc.gtpl
 var websiteTmpl string
 
@@ -112,22 +118,24 @@ func Create(listName, snakeName string, comments, framework, force bool) error {
 		return fmt.Errorf("writing list resource test template: %w", err)
 	}
 
-	tcf := "main.tf"
-	{
-		tcf = filepath.Join("testdata", listName, "list_basic", tcf)
-		err = os.MkdirAll(filepath.Dir(tcf), 0755)
-		if err != nil {
+	for _, v := range []struct {
+		dir, configTmpl, queryTmpl string
+	}{
+		{"list_basic", lisTestConfigTmpl, queryTmpl},
+		{"list_region_override", listTestConfigRegionOverrideTmpl, queryRegionOverrideTmpl},
+	} {
+		dir := filepath.Join("testdata", listName, v.dir)
+		if err = os.MkdirAll(dir, 0755); err != nil {
 			return fmt.Errorf("creating test config directory: %w", err)
 		}
-	}
-	if err = writeTemplate("testconfig", tcf, lisTestConfigTmpl, force, templateData); err != nil {
-		return fmt.Errorf("writing list resource test config template: %w", err)
-	}
 
-	qf := "main.tfquery.hcl"
-	qf = filepath.Join("testdata", listName, "list_basic", "query.tfquery.hcl")
-	if err = writeTemplate("queryconfig", qf, queryTmpl, force, templateData); err != nil {
-		return fmt.Errorf("writing list resource query config template: %w", err)
+		if err = writeTemplate("testconfig", filepath.Join(dir, "main.tf"), v.configTmpl, force, templateData); err != nil {
+			return fmt.Errorf("writing list resource test config template: %w", err)
+		}
+
+		if err = writeTemplate("queryconfig", filepath.Join(dir, "main.tfquery.hcl"), v.queryTmpl, force, templateData); err != nil {
+			return fmt.Errorf("writing list resource query config template: %w", err)
+		}
 	}
 
 	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, snakeName)
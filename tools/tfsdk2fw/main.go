@@ -9,11 +9,16 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"maps"
 	"os"
 	"path"
+	"reflect"
+	"runtime"
 	"slices"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2"
@@ -135,7 +140,6 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		Generator:    m.Generator,
 		IsDataSource: m.IsDataSource,
 		SchemaWriter: &sbSchema,
-		StructWriter: &sbStruct,
 	}
 
 	err := emitter.emitSchemaForResource(m.Resource)
@@ -144,21 +148,65 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		return nil, fmt.Errorf("emitting schema code: %w", err)
 	}
 
+	// The model is emitted after the schema so that any "id" attribute has been added.
+	err = emitter.emitModelFields(nil, m.Resource.Schema, &sbStruct)
+
+	if err != nil {
+		return nil, fmt.Errorf("emitting model code: %w", err)
+	}
+
+	var stateUpgraders []stateUpgrader
+	if !m.IsDataSource {
+		for _, v := range m.Resource.StateUpgraders {
+			sb := strings.Builder{}
+
+			if err := emitter.emitPriorSchema(&sb, v.Type); err != nil {
+				return nil, fmt.Errorf("emitting schema version %d code: %w", v.Version, err)
+			}
+
+			stateUpgraders = append(stateUpgraders, stateUpgrader{
+				PriorSchema: sb.String(),
+				Upgrade:     funcName(v.Upgrade),
+				Version:     v.Version,
+			})
+		}
+	}
+
+	var customizeDiff string
+	if f := m.Resource.CustomizeDiff; f != nil && !m.IsDataSource {
+		// Tags are handled by transparent tagging.
+		if v := funcName(f); v != "verify.SetTagsDiff" {
+			customizeDiff = v
+		}
+	}
+
+	tagsIdentifierAttribute := "id"
+	if _, ok := m.Resource.Schema["arn"]; ok {
+		tagsIdentifierAttribute = "arn"
+	}
+
 	templateData := &templateData{
-		DefaultCreateTimeout:         emitter.DefaultCreateTimeout,
-		DefaultReadTimeout:           emitter.DefaultReadTimeout,
-		DefaultUpdateTimeout:         emitter.DefaultUpdateTimeout,
-		DefaultDeleteTimeout:         emitter.DefaultDeleteTimeout,
+		CustomizeDiff:                customizeDiff,
+		DefaultCreateTimeout:         timeout(emitter.DefaultCreateTimeout),
+		DefaultReadTimeout:           timeout(emitter.DefaultReadTimeout),
+		DefaultUpdateTimeout:         timeout(emitter.DefaultUpdateTimeout),
+		DefaultDeleteTimeout:         timeout(emitter.DefaultDeleteTimeout),
 		EmitResourceImportState:      m.Resource.Importer != nil,
-		EmitResourceModifyPlan:       !m.IsDataSource && emitter.HasTopLevelTagsAllMap && emitter.HasTopLevelTagsMap,
+		EmitResourceModifyPlan:       customizeDiff != "",
 		EmitResourceUpdateSkeleton:   m.Resource.Update != nil || m.Resource.UpdateContext != nil || m.Resource.UpdateWithoutTimeout != nil,
+		HasTags:                      emitter.HasTopLevelTagsMap,
 		HasTimeouts:                  emitter.HasTimeouts,
 		ImportFrameworkAttr:          emitter.ImportFrameworkAttr,
 		ImportProviderFrameworkTypes: emitter.ImportProviderFrameworkTypes,
+		ImportProviderTags:           emitter.ImportProviderTags,
+		Models:                       emitter.Models,
 		Name:                         m.Name,
 		PackageName:                  m.PackageName,
+		PluginSDKSchemaVersion:       m.Resource.SchemaVersion,
 		Schema:                       sbSchema.String(),
+		StateUpgraders:               stateUpgraders,
 		Struct:                       sbStruct.String(),
+		TagsIdentifierAttribute:      tagsIdentifierAttribute,
 		TFTypeName:                   m.TFTypeName,
 	}
 
@@ -199,9 +247,11 @@ type emitter struct {
 	HasTopLevelTagsMap            bool
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
+	ImportProviderTags            bool
 	IsDataSource                  bool
+	Models                        []string // Nested model type definitions, in the order they were emitted.
 	SchemaWriter                  io.Writer
-	StructWriter                  io.Writer
+	modelNames                    map[string]string // Nested model type name, keyed by path.
 }
 
 // emitSchemaForResource generates the Plugin Framework code for a Plugin SDK Resource and emits the generated code to the emitter's Writer.
@@ -241,8 +291,9 @@ func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 		return err
 	}
 
-	if version := resource.SchemaVersion; version > 0 {
-		fprintf(e.SchemaWriter, "Version:%d,\n", version)
+	// The Plugin SDK schema version is upgraded to the next version by a state upgrader that reads the Plugin SDK state.
+	if !e.IsDataSource {
+		fprintf(e.SchemaWriter, "Version:%d,\n", resource.SchemaVersion+1)
 	}
 
 	if description := resource.Description; description != "" {
@@ -295,10 +346,6 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 		}
 		fprintf(e.SchemaWriter, "%q:", name)
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
-		}
-
 		if name == "id" && isTopLevelAttribute {
 			fprintf(e.SchemaWriter, "framework.IDAttribute()")
 		} else {
@@ -307,10 +354,6 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 			}
 		}
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
-		}
-
 		fprintf(e.SchemaWriter, ",\n")
 	}
 	if emittedFieldName {
@@ -351,12 +394,27 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 // and emits the generated code to the emitter's Writer.
 func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema) error {
 	attributeName := path[len(path)-1]
-	isComputedOnly := property.Computed && !property.Optional
 	isTopLevelAttribute := len(path) == 1
 	var planModifiers []string
 	var defaultSpec string
 	var fwPlanModifierPackage, fwPlanModifierType, fwValidatorsPackage, fwValidatorType string
 
+	if isTopLevelAttribute {
+		switch attributeName {
+		case "tags":
+			e.HasTopLevelTagsMap = true
+		case "tags_all":
+			e.HasTopLevelTagsAllMap = true
+		}
+
+		if v := e.tagsAttribute(path, property); v != "" {
+			e.ImportProviderTags = true
+			fprintf(e.SchemaWriter, "%s", v)
+
+			return nil
+		}
+	}
+
 	// At this point we are emitting code for the values of a schema.Schema's Attributes (map[string]schema.Attribute).
 	switch v := property.Type; v {
 	//
@@ -365,50 +423,28 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeBool:
 		fprintf(e.SchemaWriter, "schema.BoolAttribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Bool")
-		}
-
 		fwPlanModifierPackage = "boolplanmodifier"
 		fwPlanModifierType = "Bool"
 
 	case schema.TypeFloat:
 		fprintf(e.SchemaWriter, "schema.Float64Attribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Float64")
-		}
-
 		fwPlanModifierPackage = "float64planmodifier"
 		fwPlanModifierType = "Float64"
 
 	case schema.TypeInt:
 		fprintf(e.SchemaWriter, "schema.Int64Attribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Int64")
-		}
-
 		fwPlanModifierPackage = "int64planmodifier"
 		fwPlanModifierType = "Int64"
 
 	case schema.TypeString:
-		// Computed-only ARN attributes are easiest handled as strings.
-		if (attributeName == "arn" || strings.HasSuffix(attributeName, "_arn")) && !isComputedOnly {
+		fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
+
+		if isARN(attributeName, property) {
 			e.ImportProviderFrameworkTypes = true
 
-			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
 			fprintf(e.SchemaWriter, "CustomType:fwtypes.ARNType,\n")
-
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "fwtypes.ARN")
-			}
-		} else {
-			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
-
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.String")
-			}
 		}
 
 		fwPlanModifierPackage = "stringplanmodifier"
@@ -425,10 +461,6 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.ListAttribute{"
 			typeName = "list"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.List")
-			}
-
 			fwPlanModifierPackage = "listplanmodifier"
 			fwPlanModifierType = "List"
 			fwValidatorsPackage = "listvalidator"
@@ -438,10 +470,6 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.MapAttribute{"
 			typeName = "map"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Map")
-			}
-
 			fwPlanModifierPackage = "mapplanmodifier"
 			fwPlanModifierType = "Map"
 			fwValidatorsPackage = "mapvalidator"
@@ -451,10 +479,6 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.SetAttribute{"
 			typeName = "set"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Set")
-			}
-
 			fwPlanModifierPackage = "setplanmodifier"
 			fwPlanModifierType = "Set"
 			fwValidatorsPackage = "setvalidator"
@@ -477,38 +501,49 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 			case schema.TypeString:
 				elementType = "types.StringType"
-				// Special handling for 'tags' and 'tags_all'.
-				if typeName == "map" && isTopLevelAttribute {
-					if attributeName == "tags" {
-						e.HasTopLevelTagsMap = true
-						if property.Optional {
-							fprintf(e.SchemaWriter, "// TODO tftags.TagsAttribute()\n")
-						} else if property.Computed {
-							fprintf(e.SchemaWriter, "// TODO tftags.TagsAttributeComputedOnly()\n")
-						}
-					} else if attributeName == "tags_all" {
-						e.HasTopLevelTagsAllMap = true
-						fprintf(e.SchemaWriter, "// TODO tftags.TagsAttributeComputedOnly()\n")
-					}
-				}
 
 			default:
 				return unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %s", typeName, v.String()))
 			}
 
 			fprintf(e.SchemaWriter, "%s\n", aggregateSchemaFactory)
+
+			if customType, _ := collectionOfPrimitiveType(property.Type, v.Type); customType != "" {
+				e.ImportProviderFrameworkTypes = true
+
+				fprintf(e.SchemaWriter, "CustomType:%s,\n", customType)
+			}
+
 			fprintf(e.SchemaWriter, "ElementType:%s,\n", elementType)
 
 		case *schema.Resource:
 			// We get here for Computed-only nested blocks or when ConfigMode is SchemaConfigModeBlock.
 			fprintf(e.SchemaWriter, "%s\n", aggregateSchemaFactory)
-			fprintf(e.SchemaWriter, "ElementType:")
 
-			if err := e.emitComputedOnlyBlock(path, v.Schema); err != nil {
+			if typeName == "map" {
+				fprintf(e.SchemaWriter, "ElementType:")
+
+				if err := e.emitComputedOnlyBlock(path, v.Schema); err != nil {
+					return err
+				}
+
+				fprintf(e.SchemaWriter, ",\n")
+
+				break
+			}
+
+			modelName, err := e.nestedModel(path, v.Schema)
+
+			if err != nil {
 				return err
 			}
 
-			fprintf(e.SchemaWriter, ",\n")
+			e.ImportProviderFrameworkTypes = true
+
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.New%sNestedObjectTypeOf[%s](ctx),\n", fwValidatorType, modelName)
+			fprintf(e.SchemaWriter, "ElementType:types.ObjectType{\n")
+			fprintf(e.SchemaWriter, "AttrTypes:fwtypes.AttributeTypesMust[%s](ctx),\n", modelName)
+			fprintf(e.SchemaWriter, "},\n")
 
 		default:
 			return unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %T", typeName, v))
@@ -633,10 +668,19 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 			fwValidatorsPackage = "listvalidator"
 			fwValidatorType = "List"
 
+			modelName, err := e.nestedModel(path, v.Schema)
+
+			if err != nil {
+				return err
+			}
+
+			e.ImportProviderFrameworkTypes = true
+
 			fprintf(e.SchemaWriter, "schema.ListNestedBlock{\n")
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.NewListNestedObjectTypeOf[%s](ctx),\n", modelName)
 			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
 
-			err := e.emitAttributesAndBlocks(path, v.Schema)
+			err = e.emitAttributesAndBlocks(path, v.Schema)
 
 			if err != nil {
 				return err
@@ -656,10 +700,19 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 			fwValidatorsPackage = "setvalidator"
 			fwValidatorType = "Set"
 
+			modelName, err := e.nestedModel(path, v.Schema)
+
+			if err != nil {
+				return err
+			}
+
+			e.ImportProviderFrameworkTypes = true
+
 			fprintf(e.SchemaWriter, "schema.SetNestedBlock{\n")
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.NewSetNestedObjectTypeOf[%s](ctx),\n", modelName)
 			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
 
-			err := e.emitAttributesAndBlocks(path, v.Schema)
+			err = e.emitAttributesAndBlocks(path, v.Schema)
 
 			if err != nil {
 				return err
@@ -854,6 +907,310 @@ func (e *emitter) emitComputedOnlyBlockProperty(path []string, property *schema.
 	return nil
 }
 
+// emitModelFields generates the autoflex-compatible model struct fields for a set of Plugin SDK Attributes and Blocks
+// and emits the generated code to the specified Writer.
+// Property names are sorted prior to code generation to reduce diffs.
+func (e *emitter) emitModelFields(path []string, schema map[string]*schema.Schema, w io.Writer) error {
+	names := make([]string, 0)
+	for name := range schema {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		fieldType, err := e.modelFieldType(append(path, name), schema[name])
+
+		if err != nil {
+			return err
+		}
+
+		fprintf(w, "%s %s `tfsdk:%q`\n", naming.ToCamelCase(name), fieldType, name)
+	}
+
+	return nil
+}
+
+// modelFieldType returns the Go type of the model struct field for a Plugin SDK property.
+// The type must match the Plugin Framework schema emitted for the property.
+func (e *emitter) modelFieldType(path []string, property *schema.Schema) (string, error) {
+	attributeName := path[len(path)-1]
+	isTopLevelAttribute := len(path) == 1
+
+	if isTopLevelAttribute {
+		if attributeName == "id" {
+			return "types.String", nil
+		}
+
+		if e.tagsAttribute(path, property) != "" {
+			return "tftags.Map", nil
+		}
+	}
+
+	switch v := property.Type; v {
+	case schema.TypeBool:
+		return "types.Bool", nil
+
+	case schema.TypeFloat:
+		return "types.Float64", nil
+
+	case schema.TypeInt:
+		return "types.Int64", nil
+
+	case schema.TypeString:
+		if isARN(attributeName, property) {
+			return "fwtypes.ARN", nil
+		}
+
+		return "types.String", nil
+
+	case schema.TypeList, schema.TypeMap, schema.TypeSet:
+		switch elem := property.Elem.(type) {
+		case *schema.Schema:
+			_, valueType := collectionOfPrimitiveType(v, elem.Type)
+
+			return valueType, nil
+
+		case *schema.Resource:
+			if v == schema.TypeMap {
+				return "types.Map", nil
+			}
+
+			modelName, err := e.nestedModel(path, elem.Schema)
+
+			if err != nil {
+				return "", err
+			}
+
+			if v == schema.TypeSet {
+				return fmt.Sprintf("fwtypes.SetNestedObjectValueOf[%s]", modelName), nil
+			}
+
+			return fmt.Sprintf("fwtypes.ListNestedObjectValueOf[%s]", modelName), nil
+
+		default:
+			return "", unsupportedTypeError(path, fmt.Sprintf("(Model) %s of %T", v.String(), elem))
+		}
+
+	default:
+		return "", unsupportedTypeError(path, v.String())
+	}
+}
+
+// nestedModel returns the name of the model struct for a Plugin SDK nested block,
+// emitting the struct's definition the first time the block is seen.
+func (e *emitter) nestedModel(path []string, schema map[string]*schema.Schema) (string, error) {
+	key := strings.Join(path, "/")
+
+	if v, ok := e.modelNames[key]; ok {
+		return v, nil
+	}
+
+	if e.modelNames == nil {
+		e.modelNames = make(map[string]string)
+	}
+
+	// Prefer the block's name, falling back to the full path to disambiguate blocks with the same name.
+	modelName := naming.ToLowerCamelCase(path[len(path)-1]) + "Model"
+	if slices.Contains(slices.Collect(maps.Values(e.modelNames)), modelName) {
+		modelName = naming.ToLowerCamelCase(strings.Join(path, "_")) + "Model"
+	}
+	e.modelNames[key] = modelName
+
+	// Reserve the definition's position so that parents precede their children.
+	i := len(e.Models)
+	e.Models = append(e.Models, "")
+
+	sb := strings.Builder{}
+
+	if err := e.emitModelFields(path, schema, &sb); err != nil {
+		return "", err
+	}
+
+	e.Models[i] = fmt.Sprintf("type %s struct {\n%s}\n", modelName, sb.String())
+
+	return modelName, nil
+}
+
+// tagsAttribute returns the Plugin Framework code for a top-level `tags` or `tags_all` attribute, if any.
+func (e *emitter) tagsAttribute(path []string, property *schema.Schema) string {
+	if len(path) != 1 || property.Type != schema.TypeMap {
+		return ""
+	}
+
+	if v, ok := property.Elem.(*schema.Schema); !ok || v.Type != schema.TypeString {
+		return ""
+	}
+
+	switch isComputedOnly := property.Computed && !property.Optional; path[0] {
+	case "tags":
+		switch {
+		case isComputedOnly:
+			return "tftags.TagsAttributeComputedOnly()"
+		case e.IsDataSource:
+			return ""
+		case property.Required:
+			return "tftags.TagsAttributeRequired()"
+		default:
+			return "tftags.TagsAttribute()"
+		}
+
+	case "tags_all":
+		if isComputedOnly {
+			return "tftags.TagsAttributeComputedOnly()"
+		}
+	}
+
+	return ""
+}
+
+// emitPriorSchema generates the Plugin Framework code for a prior schema version from the Plugin SDK state upgrader's type
+// and emits the generated code to the specified Writer.
+// The prior schema is only used to decode state so all attributes are Optional.
+// Attribute names are sorted prior to code generation to reduce diffs.
+func (e *emitter) emitPriorSchema(w io.Writer, typ cty.Type) error {
+	if !typ.IsObjectType() {
+		return fmt.Errorf("unsupported state upgrader type: %s", typ.FriendlyName())
+	}
+
+	fprintf(w, "schema.Schema{\n")
+	fprintf(w, "Attributes: map[string]schema.Attribute{\n")
+
+	attributeTypes := typ.AttributeTypes()
+	for _, name := range slices.Sorted(maps.Keys(attributeTypes)) {
+		fprintf(w, "%q:", name)
+
+		switch v := attributeTypes[name]; {
+		case v.Equals(cty.Bool):
+			fprintf(w, "schema.BoolAttribute{\n")
+
+		case v.Equals(cty.Number):
+			fprintf(w, "schema.NumberAttribute{\n")
+
+		case v.Equals(cty.String):
+			fprintf(w, "schema.StringAttribute{\n")
+
+		case v.Equals(cty.DynamicPseudoType):
+			fprintf(w, "schema.DynamicAttribute{\n")
+
+		case v.IsListType(), v.IsSetType(), v.IsMapType():
+			var aggregateSchemaFactory string
+
+			switch {
+			case v.IsListType():
+				aggregateSchemaFactory = "schema.ListAttribute{"
+			case v.IsSetType():
+				aggregateSchemaFactory = "schema.SetAttribute{"
+			case v.IsMapType():
+				aggregateSchemaFactory = "schema.MapAttribute{"
+			}
+
+			fprintf(w, "%s\n", aggregateSchemaFactory)
+			fprintf(w, "ElementType:")
+
+			if err := e.emitAttrType(w, v.ElementType()); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+
+			fprintf(w, ",\n")
+
+		case v.IsObjectType():
+			fprintf(w, "schema.ObjectAttribute{\n")
+			fprintf(w, "AttributeTypes:")
+
+			if err := e.emitObjectAttrTypes(w, v); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+
+			fprintf(w, ",\n")
+
+		default:
+			return fmt.Errorf("%s: unsupported state upgrader type: %s", name, v.FriendlyName())
+		}
+
+		fprintf(w, "Optional:true,\n")
+		fprintf(w, "},\n")
+	}
+
+	fprintf(w, "},\n")
+	fprintf(w, "}")
+
+	return nil
+}
+
+// emitAttrType generates the Plugin Framework attr.Type code for a cty.Type and emits the generated code to the specified Writer.
+func (e *emitter) emitAttrType(w io.Writer, typ cty.Type) error {
+	switch {
+	case typ.Equals(cty.Bool):
+		fprintf(w, "types.BoolType")
+
+	case typ.Equals(cty.Number):
+		fprintf(w, "types.NumberType")
+
+	case typ.Equals(cty.String):
+		fprintf(w, "types.StringType")
+
+	case typ.Equals(cty.DynamicPseudoType):
+		fprintf(w, "types.DynamicType")
+
+	case typ.IsListType(), typ.IsSetType(), typ.IsMapType():
+		var aggregateType string
+
+		switch {
+		case typ.IsListType():
+			aggregateType = "types.ListType"
+		case typ.IsSetType():
+			aggregateType = "types.SetType"
+		case typ.IsMapType():
+			aggregateType = "types.MapType"
+		}
+
+		fprintf(w, "%s{ElemType:", aggregateType)
+
+		if err := e.emitAttrType(w, typ.ElementType()); err != nil {
+			return err
+		}
+
+		fprintf(w, "}")
+
+	case typ.IsObjectType():
+		fprintf(w, "types.ObjectType{AttrTypes:")
+
+		if err := e.emitObjectAttrTypes(w, typ); err != nil {
+			return err
+		}
+
+		fprintf(w, "}")
+
+	default:
+		return fmt.Errorf("unsupported state upgrader type: %s", typ.FriendlyName())
+	}
+
+	return nil
+}
+
+// emitObjectAttrTypes generates the Plugin Framework code for a cty object type's attribute types
+// and emits the generated code to the specified Writer.
+func (e *emitter) emitObjectAttrTypes(w io.Writer, typ cty.Type) error {
+	e.ImportFrameworkAttr = true
+
+	fprintf(w, "map[string]attr.Type{\n")
+
+	attributeTypes := typ.AttributeTypes()
+	for _, name := range slices.Sorted(maps.Keys(attributeTypes)) {
+		fprintf(w, "%q:", name)
+
+		if err := e.emitAttrType(w, attributeTypes[name]); err != nil {
+			return err
+		}
+
+		fprintf(w, ",\n")
+	}
+
+	fprintf(w, "}")
+
+	return nil
+}
+
 // warnf emits a formatted warning message to the UI.
 func (e *emitter) warnf(format string, a ...any) {
 	e.Generator.Warnf(format, a...)
@@ -897,31 +1254,112 @@ func isAttribute(property *schema.Schema) bool {
 	return false
 }
 
+// isARN returns whether or not the specified property should be emitted as an ARN.
+// Computed-only ARN attributes are easiest handled as strings.
+func isARN(name string, property *schema.Schema) bool {
+	isComputedOnly := property.Computed && !property.Optional
+
+	return (name == "arn" || strings.HasSuffix(name, "_arn")) && !isComputedOnly
+}
+
+// collectionOfPrimitiveType returns the Plugin Framework custom type, if any, and value type for a collection of primitives.
+func collectionOfPrimitiveType(collectionType, elementType schema.ValueType) (string, string) {
+	switch collectionType {
+	case schema.TypeList:
+		switch elementType {
+		case schema.TypeInt:
+			return "fwtypes.ListOfInt64Type", "fwtypes.ListOfInt64"
+		case schema.TypeString:
+			return "fwtypes.ListOfStringType", "fwtypes.ListOfString"
+		}
+
+		return "", "types.List"
+
+	case schema.TypeMap:
+		if elementType == schema.TypeString {
+			return "fwtypes.MapOfStringType", "fwtypes.MapOfString"
+		}
+
+		return "", "types.Map"
+
+	default:
+		if elementType == schema.TypeString {
+			return "fwtypes.SetOfStringType", "fwtypes.SetOfString"
+		}
+
+		return "", "types.Set"
+	}
+}
+
+// funcName returns the package-qualified name of the specified function, e.g. "verify.SetTagsDiff".
+func funcName(f any) string {
+	v := reflect.ValueOf(f)
+
+	if v.Kind() != reflect.Func || v.IsNil() {
+		return ""
+	}
+
+	if f := runtime.FuncForPC(v.Pointer()); f != nil {
+		return path.Base(f.Name())
+	}
+
+	return ""
+}
+
 func unsupportedTypeError(path []string, typ string) error {
 	return fmt.Errorf("%s is of unsupported type: %s", strings.Join(path, "/"), typ)
 }
 
 type templateData struct {
-	DefaultCreateTimeout          int64
-	DefaultReadTimeout            int64
-	DefaultUpdateTimeout          int64
-	DefaultDeleteTimeout          int64
+	CustomizeDiff                 string // e.g. customdiff.All.func1
+	DefaultCreateTimeout          timeout
+	DefaultReadTimeout            timeout
+	DefaultUpdateTimeout          timeout
+	DefaultDeleteTimeout          timeout
 	EmitResourceImportState       bool
 	EmitResourceModifyPlan        bool
 	EmitResourceUpdateSkeleton    bool
 	FrameworkPlanModifierPackages []string
 	FrameworkValidatorsPackages   []string
 	GoImports                     []goImport
+	HasTags                       bool
 	HasTimeouts                   bool
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
+	ImportProviderTags            bool
+	Models                        []string
 	Name                          string // e.g. Instance
 	PackageName                   string // e.g. ec2
+	PluginSDKSchemaVersion        int
 	Schema                        string
+	StateUpgraders                []stateUpgrader
 	Struct                        string
+	TagsIdentifierAttribute       string // e.g. arn
 	TFTypeName                    string // e.g. aws_instance
 }
 
+type stateUpgrader struct {
+	PriorSchema string
+	Upgrade     string // e.g. instanceStateUpgradeV0
+	Version     int
+}
+
+// timeout is a default timeout, in nanoseconds, that is formatted as a human-friendly Go duration expression.
+type timeout int64
+
+func (t timeout) String() string {
+	switch d := time.Duration(t); {
+	case d%time.Hour == 0:
+		return fmt.Sprintf("%d * time.Hour", d/time.Hour)
+	case d%time.Minute == 0:
+		return fmt.Sprintf("%d * time.Minute", d/time.Minute)
+	case d%time.Second == 0:
+		return fmt.Sprintf("%d * time.Second", d/time.Second)
+	default:
+		return fmt.Sprintf("%d * time.Nanosecond", d)
+	}
+}
+
 //go:embed datasource.gtpl
 var datasourceImpl string
 
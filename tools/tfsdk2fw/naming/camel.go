@@ -45,6 +45,22 @@ func ToCamelCase(s string) string {
 	return s
 }
 
+// ToLowerCamelCase converts a string to lowerCamelCase.
+func ToLowerCamelCase(s string) string {
+	s = ToCamelCase(s)
+
+	// Lower case the first letter or any leading initialism, e.g. "ARN" -> "arn".
+	n := 0
+	for n < len(s) && isCapitalLetter(s[n]) {
+		n++
+	}
+	if n > 1 && n < len(s) && isLowercaseLetter(s[n]) {
+		n--
+	}
+
+	return strings.ToLower(s[:n]) + s[n:]
+}
+
 func isCapitalLetter(ch byte) bool {
 	return ch >= 'A' && ch <= 'Z'
 }